@@ -385,6 +385,11 @@ func (c *Client) parseResponse(
 		fmt.Fprintf(os.Stderr, "%s %s\n%s\n", requestID, resp.Status, body)
 	}
 
+	// Some endpoints, such as removeUser, reply without a body
+	if resp.StatusCode == http.StatusNoContent {
+		return &Response{}, nil
+	}
+
 	// Decode the response
 	value := &Response{}
 	if err := json.Unmarshal(body, value); err != nil {
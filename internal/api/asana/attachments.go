@@ -3,6 +3,7 @@ package asana
 import (
 	"fmt"
 	"io"
+	"net/http"
 	"time"
 
 	"github.com/pkg/errors"
@@ -75,6 +76,87 @@ func (t *Task) Attachments(client *Client, opts ...*Options) ([]*Attachment, *Ne
 	return result, nextPage, err
 }
 
+// Fetch loads the full details for this Attachment, including a fresh
+// download URL
+func (a *Attachment) Fetch(client *Client, opts ...*Options) error {
+	client.trace("Loading attachment details for %s", a.ID)
+
+	_, err := client.get(fmt.Sprintf("/attachments/%s", a.ID), nil, a, opts...)
+	return err
+}
+
+// AttachmentContent is the body of an attachment download.
+type AttachmentContent struct {
+	io.ReadCloser
+
+	// The byte offset the body starts at. This is zero if the host ignored
+	// the requested offset and sends the whole file.
+	Offset int64
+
+	// The total size of the file in bytes, or -1 if unknown.
+	Size int64
+}
+
+// downloadClient fetches attachment content. It does not send the API
+// credentials and gives up when the host does not start responding in time,
+// without limiting how long the download itself may take.
+var downloadClient = newDownloadClient()
+
+func newDownloadClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.ResponseHeaderTimeout = 30 * time.Second
+	return &http.Client{Transport: transport}
+}
+
+// Download opens the content of the attachment from its download URL,
+// starting at the given byte offset. Download URLs are pre-signed and expire
+// after an hour, so they are requested without API credentials and should be
+// refreshed with Fetch shortly before downloading.
+func (a *Attachment) Download(client *Client, offset int64) (*AttachmentContent, error) {
+	if a.DownloadURL == "" {
+		return nil, fmt.Errorf("attachment %s has no download URL", a.ID)
+	}
+	client.trace("Downloading attachment %q from offset %d", a.Name, offset)
+
+	req, err := http.NewRequest(http.MethodGet, a.DownloadURL, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "Download attachment")
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := downloadClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "Download attachment")
+	}
+
+	content := &AttachmentContent{ReadCloser: resp.Body, Size: -1}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if resp.ContentLength >= 0 {
+			content.Size = resp.ContentLength
+		}
+	case http.StatusPartialContent:
+		content.Offset = offset
+		if resp.ContentLength >= 0 {
+			content.Size = offset + resp.ContentLength
+		}
+	case http.StatusRequestedRangeNotSatisfiable:
+		// The offset is past the end of the file, so start over.
+		resp.Body.Close()
+		if offset > 0 {
+			return a.Download(client, 0)
+		}
+		return nil, fmt.Errorf("download attachment: unexpected status %s", resp.Status)
+	default:
+		resp.Body.Close()
+		return nil, fmt.Errorf("download attachment: unexpected status %s", resp.Status)
+	}
+
+	return content, nil
+}
+
 type NewAttachment struct {
 	Reader      io.ReadCloser
 	FileName    string
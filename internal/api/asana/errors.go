@@ -93,6 +93,15 @@ func IsAuthError(err error) bool {
 	return false
 }
 
+// IsForbiddenError checks if the provided error represents a 403 Forbidden
+// response from the API, such as when editing a locked custom field
+func IsForbiddenError(err error) bool {
+	if e, ok := IsAsanaError(err); ok {
+		return e.StatusCode == http.StatusForbidden
+	}
+	return false
+}
+
 // IsRateLimited returns true if the error was a rate limit error
 func IsRateLimited(err error) bool {
 	if e, ok := IsAsanaError(err); ok {
@@ -0,0 +1,326 @@
+package asana
+
+import (
+	"fmt"
+	"time"
+)
+
+// GoalStatus is the state of a goal. Open goals are green, yellow or red;
+// closed goals are achieved, partial, missed or dropped.
+type GoalStatus string
+
+const (
+	GoalOnTrack  GoalStatus = "green"
+	GoalAtRisk   GoalStatus = "yellow"
+	GoalOffTrack GoalStatus = "red"
+	GoalAchieved GoalStatus = "achieved"
+	GoalPartial  GoalStatus = "partial"
+	GoalMissed   GoalStatus = "missed"
+	GoalDropped  GoalStatus = "dropped"
+)
+
+// MetricUnit is the unit of a goal metric
+type MetricUnit string
+
+const (
+	MetricUnitNone       MetricUnit = "none"
+	MetricUnitCurrency   MetricUnit = "currency"
+	MetricUnitPercentage MetricUnit = "percentage"
+)
+
+// GoalMetric measures the progress of a goal
+type GoalMetric struct {
+	// Read-only. Globally unique ID of the object
+	ID string `json:"gid,omitempty"`
+
+	// The type of the metric, currently always number
+	ResourceSubtype string `json:"resource_subtype,omitempty"`
+
+	// The number of decimal places of the values
+	Precision *int `json:"precision,omitempty"`
+
+	// The unit of the values. Percentages are stored as fractions, 0.5 for 50%.
+	Unit MetricUnit `json:"unit,omitempty"`
+
+	// ISO 4217 currency code of currency metrics
+	CurrencyCode string `json:"currency_code,omitempty"`
+
+	InitialNumberValue *float64 `json:"initial_number_value,omitempty"`
+	TargetNumberValue  *float64 `json:"target_number_value,omitempty"`
+	CurrentNumberValue *float64 `json:"current_number_value,omitempty"`
+
+	// Read-only. The current value formatted by Asana
+	CurrentDisplayValue string `json:"current_display_value,omitempty"`
+
+	// How progress is measured: manual, subgoal_progress,
+	// project_task_completion, project_milestone_completion,
+	// task_completion or external
+	ProgressSource string `json:"progress_source,omitempty"`
+}
+
+// TimePeriod is a fiscal year, half or quarter that goals are set for
+type TimePeriod struct {
+	// Read-only. Globally unique ID of the object
+	ID string `json:"gid,omitempty"`
+
+	// The name shown in Asana, such as "Q1 FY25"
+	DisplayName string `json:"display_name,omitempty"`
+
+	// The kind of period: FY, H1, H2, Q1, Q2, Q3 or Q4
+	Period string `json:"period,omitempty"`
+
+	StartOn *Date `json:"start_on,omitempty"`
+	EndOn   *Date `json:"end_on,omitempty"`
+
+	// The period containing this one, such as the fiscal year of a quarter
+	Parent *TimePeriod `json:"parent,omitempty"`
+}
+
+// GoalBase contains the parts of Goal which are not related to a specific instance
+type GoalBase struct {
+	// The name of the goal.
+	Name string `json:"name,omitempty"`
+
+	// Free-form textual information associated with the goal.
+	Notes string `json:"notes,omitempty"`
+
+	// The notes of the goal with formatting as HTML.
+	HTMLNotes string `json:"html_notes,omitempty"`
+
+	// The days on which the goal starts and is due.
+	StartOn *Date `json:"start_on,omitempty"`
+	DueOn   *Date `json:"due_on,omitempty"`
+
+	// The current status of the goal.
+	Status GoalStatus `json:"status,omitempty"`
+
+	// Whether the goal belongs to the workspace rather than a team.
+	IsWorkspaceLevel *bool `json:"is_workspace_level,omitempty"`
+}
+
+// Goal is an objective tracked in Asana, measured by a metric and supported
+// by other goals, projects and portfolios.
+type Goal struct {
+	// Read-only. Globally unique ID of the object
+	ID string `json:"gid,omitempty"`
+
+	GoalBase
+
+	// The owner of the goal.
+	Owner *User `json:"owner,omitempty"`
+
+	// The team of a team-level goal.
+	Team *Team `json:"team,omitempty"`
+
+	// The workspace the goal belongs to.
+	Workspace *Workspace `json:"workspace,omitempty"`
+
+	// The time period the goal is set for.
+	TimePeriod *TimePeriod `json:"time_period,omitempty"`
+
+	// The metric measuring the goal, if any.
+	Metric *GoalMetric `json:"metric,omitempty"`
+
+	// Read-only. The latest status update posted to the goal.
+	CurrentStatusUpdate *StatusUpdate `json:"current_status_update,omitempty"`
+
+	// Read-only. The users following the goal.
+	Followers []*User `json:"followers,omitempty"`
+
+	// Read-only. The time at which this object was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// GoalSupportingResource is a goal, project, portfolio or task supporting a goal
+type GoalSupportingResource struct {
+	// Read-only. Globally unique ID of the object
+	ID string `json:"gid,omitempty"`
+
+	// Read-only. The type of the resource, such as goal or project
+	ResourceType string `json:"resource_type,omitempty"`
+
+	// Read-only. The name of the resource.
+	Name string `json:"name,omitempty"`
+}
+
+// GoalRelationship links a goal to a resource supporting it
+type GoalRelationship struct {
+	// Read-only. Globally unique ID of the object
+	ID string `json:"gid,omitempty"`
+
+	// Read-only. The kind of relationship, subgoal or supporting_work
+	ResourceSubtype string `json:"resource_subtype,omitempty"`
+
+	// The goal, project, portfolio or task supporting the goal
+	SupportingResource *GoalSupportingResource `json:"supporting_resource,omitempty"`
+
+	// How much the supporting resource counts towards the progress of the goal
+	ContributionWeight *float64 `json:"contribution_weight,omitempty"`
+}
+
+// GoalQuery filters the goals returned by QueryGoals
+type GoalQuery struct {
+	Workspace string `url:"workspace,omitempty"`
+	Team      string `url:"team,omitempty"`
+	Project   string `url:"project,omitempty"`
+	Portfolio string `url:"portfolio,omitempty"`
+
+	// Globally unique identifiers of time periods
+	TimePeriods []string `url:"time_periods,omitempty,comma"`
+
+	IsWorkspaceLevel *bool `url:"is_workspace_level,omitempty"`
+}
+
+// UpdateGoalRequest represents a request to update a goal
+type UpdateGoalRequest struct {
+	GoalBase
+
+	Owner      string `json:"owner,omitempty"`
+	TimePeriod string `json:"time_period,omitempty"`
+}
+
+// QueryGoals returns the compact goal records matching the query
+func (c *Client) QueryGoals(query *GoalQuery, opts ...*Options) ([]*Goal, *NextPage, error) {
+	c.trace("Querying goals")
+
+	var result []*Goal
+	nextPage, err := c.get("/goals", query, &result, opts...)
+	return result, nextPage, err
+}
+
+// AllGoals repeatedly pages through all goals matching the query
+func (c *Client) AllGoals(query *GoalQuery, options ...*Options) ([]*Goal, error) {
+	var allGoals []*Goal
+	nextPage := &NextPage{}
+
+	var goals []*Goal
+	var err error
+
+	for nextPage != nil {
+		page := &Options{
+			Limit:  100,
+			Offset: nextPage.Offset,
+		}
+
+		allOptions := append([]*Options{page}, options...)
+		goals, nextPage, err = c.QueryGoals(query, allOptions...)
+		if err != nil {
+			return nil, err
+		}
+
+		allGoals = append(allGoals, goals...)
+	}
+	return allGoals, nil
+}
+
+// Fetch loads the full details for this goal
+func (g *Goal) Fetch(client *Client, opts ...*Options) error {
+	client.trace("Loading goal details for %q", g.Name)
+
+	_, err := client.get(fmt.Sprintf("/goals/%s", g.ID), nil, g, opts...)
+	return err
+}
+
+// Update changes the given fields of the goal
+func (g *Goal) Update(client *Client, request *UpdateGoalRequest, opts ...*Options) error {
+	client.info("Updating goal %q", g.Name)
+
+	return client.put(fmt.Sprintf("/goals/%s", g.ID), request, g, opts...)
+}
+
+type setMetricRequest struct {
+	CurrentNumberValue float64 `json:"current_number_value"`
+}
+
+// SetMetricCurrentValue records the current value of the goal's metric
+func (g *Goal) SetMetricCurrentValue(client *Client, value float64) error {
+	client.info("Setting metric of goal %q to %v", g.Name, value)
+
+	return client.post(fmt.Sprintf("/goals/%s/setMetricCurrentValue", g.ID), &setMetricRequest{CurrentNumberValue: value}, g)
+}
+
+// ParentGoals returns the goals this goal supports
+func (g *Goal) ParentGoals(client *Client, opts ...*Options) ([]*Goal, error) {
+	client.trace("Listing parent goals of %q", g.Name)
+
+	var result []*Goal
+	_, err := client.get(fmt.Sprintf("/goals/%s/parentGoals", g.ID), nil, &result, opts...)
+	return result, err
+}
+
+type goalRelationshipsQuery struct {
+	SupportedGoal string `url:"supported_goal"`
+}
+
+// Relationships returns the goals, projects, portfolios and tasks supporting
+// this goal
+func (g *Goal) Relationships(client *Client, opts ...*Options) ([]*GoalRelationship, *NextPage, error) {
+	client.trace("Listing supporting resources of goal %q", g.Name)
+
+	var result []*GoalRelationship
+	nextPage, err := client.get("/goal_relationships", &goalRelationshipsQuery{SupportedGoal: g.ID}, &result, opts...)
+	return result, nextPage, err
+}
+
+// AllRelationships repeatedly pages through the resources supporting this goal
+func (g *Goal) AllRelationships(client *Client, options ...*Options) ([]*GoalRelationship, error) {
+	var allRelationships []*GoalRelationship
+	nextPage := &NextPage{}
+
+	var relationships []*GoalRelationship
+	var err error
+
+	for nextPage != nil {
+		page := &Options{
+			Limit:  100,
+			Offset: nextPage.Offset,
+		}
+
+		allOptions := append([]*Options{page}, options...)
+		relationships, nextPage, err = g.Relationships(client, allOptions...)
+		if err != nil {
+			return nil, err
+		}
+
+		allRelationships = append(allRelationships, relationships...)
+	}
+	return allRelationships, nil
+}
+
+type timePeriodsQuery struct {
+	Workspace string `url:"workspace"`
+}
+
+// TimePeriods returns the time periods of this workspace
+func (w *Workspace) TimePeriods(client *Client, opts ...*Options) ([]*TimePeriod, *NextPage, error) {
+	client.trace("Listing time periods in %q", w.Name)
+
+	var result []*TimePeriod
+	nextPage, err := client.get("/time_periods", &timePeriodsQuery{Workspace: w.ID}, &result, opts...)
+	return result, nextPage, err
+}
+
+// AllTimePeriods repeatedly pages through the time periods of this workspace
+func (w *Workspace) AllTimePeriods(client *Client, options ...*Options) ([]*TimePeriod, error) {
+	var allPeriods []*TimePeriod
+	nextPage := &NextPage{}
+
+	var periods []*TimePeriod
+	var err error
+
+	for nextPage != nil {
+		page := &Options{
+			Limit:  100,
+			Offset: nextPage.Offset,
+		}
+
+		allOptions := append([]*Options{page}, options...)
+		periods, nextPage, err = w.TimePeriods(client, allOptions...)
+		if err != nil {
+			return nil, err
+		}
+
+		allPeriods = append(allPeriods, periods...)
+	}
+	return allPeriods, nil
+}
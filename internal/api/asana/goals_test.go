@@ -0,0 +1,64 @@
+package asana
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/h2non/gock"
+)
+
+func TestClient_QueryGoals(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://app.asana.com").
+		Get("/api/1.0/goals").
+		MatchParam("workspace", "1").
+		MatchParam("team", "2").
+		MatchParam("time_periods", "3,4").
+		Reply(200).
+		JSON(o{"data": []o{{
+			"gid":    "10",
+			"name":   "Grow revenue",
+			"status": "green",
+			"metric": o{"unit": "currency", "currency_code": "EUR", "current_number_value": 12.5},
+		}}})
+
+	client := NewClient(http.DefaultClient)
+	goals, _, err := client.QueryGoals(&GoalQuery{Workspace: "1", Team: "2", TimePeriods: []string{"3", "4"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(goals) != 1 {
+		t.Fatalf("Expected 1 goal but found %d", len(goals))
+	}
+
+	g := goals[0]
+	if g.Status != GoalOnTrack {
+		t.Errorf("Expected status green but saw %s", g.Status)
+	}
+	if g.Metric == nil || g.Metric.CurrentNumberValue == nil || *g.Metric.CurrentNumberValue != 12.5 {
+		t.Errorf("Expected current value 12.5 but saw %v", g.Metric)
+	}
+}
+
+func TestGoal_SetMetricCurrentValue(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://app.asana.com").
+		Post("/api/1.0/goals/10/setMetricCurrentValue").
+		BodyString(`"current_number_value":0.75`).
+		Reply(200).
+		JSON(o{"data": o{"gid": "10", "metric": o{"current_number_value": 0.75}}})
+
+	goal := &Goal{ID: "10"}
+
+	client := NewClient(http.DefaultClient)
+	if err := goal.SetMetricCurrentValue(client, 0.75); err != nil {
+		t.Fatal(err)
+	}
+
+	if goal.Metric == nil || *goal.Metric.CurrentNumberValue != 0.75 {
+		t.Errorf("Expected the goal to be updated but saw %v", goal.Metric)
+	}
+}
@@ -0,0 +1,45 @@
+package asana
+
+import "fmt"
+
+// JobStatus is the state of an asynchronous job
+type JobStatus string
+
+const (
+	JobNotStarted JobStatus = "not_started"
+	JobInProgress JobStatus = "in_progress"
+	JobSucceeded  JobStatus = "succeeded"
+	JobFailed     JobStatus = "failed"
+)
+
+// Job represents a long-running operation, such as duplicating a project or
+// task, that completes asynchronously.
+type Job struct {
+	// Read-only. Globally unique ID of the object
+	ID string `json:"gid,omitempty"`
+
+	// The type of the job, for example duplicate_project or duplicate_task
+	ResourceSubtype string `json:"resource_subtype,omitempty"`
+
+	// The current state of the job
+	Status JobStatus `json:"status,omitempty"`
+
+	// The project created by the job, if any
+	NewProject *Project `json:"new_project,omitempty"`
+
+	// The task created by the job, if any
+	NewTask *Task `json:"new_task,omitempty"`
+}
+
+// Done reports whether the job has finished, successfully or not.
+func (j *Job) Done() bool {
+	return j.Status == JobSucceeded || j.Status == JobFailed
+}
+
+// Fetch loads the current state of the job
+func (j *Job) Fetch(client *Client, opts ...*Options) error {
+	client.trace("Loading job %s", j.ID)
+
+	_, err := client.get(fmt.Sprintf("/jobs/%s", j.ID), nil, j, opts...)
+	return err
+}
@@ -1,5 +1,7 @@
 package asana
 
+import "fmt"
+
 type AccessLevel string
 
 const (
@@ -42,10 +44,10 @@ type ProjectMembership struct {
 
 type membershipsRequestParams struct {
 	// Globally unique identifier for goal, project, or portfolio
-	Parent string `json:"parent"`
+	Parent string `url:"parent"`
 
 	// Optional - Globally unique identifier for team or user.
-	Member string `json:"member,omitempty"`
+	Member string `url:"member,omitempty"`
 }
 
 func (p *Project) Memberships(
@@ -63,6 +65,32 @@ func (p *Project) Memberships(
 	return result, nextPage, err
 }
 
+// AllMemberships repeatedly pages through all user and team memberships of
+// this project
+func (p *Project) AllMemberships(client *Client, options ...*Options) ([]*ProjectMembership, error) {
+	var allMemberships []*ProjectMembership
+	nextPage := &NextPage{}
+
+	var memberships []*ProjectMembership
+	var err error
+
+	for nextPage != nil {
+		page := &Options{
+			Limit:  100,
+			Offset: nextPage.Offset,
+		}
+
+		allOptions := append([]*Options{page}, options...)
+		memberships, nextPage, err = p.Memberships(client, allOptions...)
+		if err != nil {
+			return nil, err
+		}
+
+		allMemberships = append(allMemberships, memberships...)
+	}
+	return allMemberships, nil
+}
+
 type CreateMembershipRequest struct {
 	MemberID string
 
@@ -99,3 +127,21 @@ func (p *Project) CreateMembership(
 	err := c.post("/memberships", data, result)
 	return result, err
 }
+
+type updateMembershipRequest struct {
+	AccessLevel AccessLevel `json:"access_level"`
+}
+
+// Update changes the access level of the membership
+func (m *ProjectMembership) Update(c *Client, level AccessLevel) error {
+	c.info("Updating membership %q to %s access\n", m.ID, level)
+
+	return c.put(fmt.Sprintf("/memberships/%s", m.ID), &updateMembershipRequest{AccessLevel: level}, m)
+}
+
+// Delete removes the member from the project
+func (m *ProjectMembership) Delete(c *Client) error {
+	c.info("Deleting membership %q\n", m.ID)
+
+	return c.delete(fmt.Sprintf("/memberships/%s", m.ID))
+}
@@ -14,6 +14,7 @@ func TestProject_Memberships(t *testing.T) {
 
 	gock.New("https://app.asana.com").
 		Get("/api/1.0/memberships").
+		MatchParam("parent", "63627").
 		Reply(200).
 		JSON(o{"data": []o{{
 			"gid":              "12345",
@@ -24,7 +25,7 @@ func TestProject_Memberships(t *testing.T) {
 			"resource_subtype": "project_membership",
 		}}})
 
-	project := &Project{}
+	project := &Project{ID: "63627"}
 
 	client := NewClient(http.DefaultClient)
 	memberships, _, err := project.Memberships(client)
@@ -41,3 +42,76 @@ func TestProject_Memberships(t *testing.T) {
 		t.Errorf("Expected membership ID 12345 but saw %s", m.ID)
 	}
 }
+
+func TestProjectMembership_Update(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://app.asana.com").
+		Put("/api/1.0/memberships/12345").
+		BodyString(`"access_level":"viewer"`).
+		Reply(200).
+		JSON(o{"data": o{"gid": "12345", "access_level": "viewer"}})
+
+	m := &ProjectMembership{ID: "12345", AccessLevel: AccessLevelEditor}
+
+	client := NewClient(http.DefaultClient)
+	if err := m.Update(client, AccessLevelViewer); err != nil {
+		t.Fatal(err)
+	}
+
+	if m.AccessLevel != AccessLevelViewer {
+		t.Errorf("Expected access level viewer but saw %s", m.AccessLevel)
+	}
+}
+
+func TestProjectMembership_Delete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://app.asana.com").
+		Delete("/api/1.0/memberships/12345").
+		Reply(200).
+		JSON(o{"data": o{}})
+
+	m := &ProjectMembership{ID: "12345"}
+
+	client := NewClient(http.DefaultClient)
+	if err := m.Delete(client); err != nil {
+		t.Fatal(err)
+	}
+
+	if !gock.IsDone() {
+		t.Error("Expected the membership to be deleted")
+	}
+}
+
+func TestProject_AllMemberships(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://app.asana.com").
+		Get("/api/1.0/memberships").
+		MatchParam("parent", "1").
+		MatchParam("offset", "next").
+		Reply(200).
+		JSON(o{"data": []o{{"gid": "m2", "member": o{"gid": "u2"}}}})
+
+	gock.New("https://app.asana.com").
+		Get("/api/1.0/memberships").
+		MatchParam("parent", "1").
+		Reply(200).
+		JSON(o{
+			"data":      []o{{"gid": "m1", "member": o{"gid": "u1"}}},
+			"next_page": o{"offset": "next"},
+		})
+
+	project := &Project{ID: "1"}
+
+	client := NewClient(http.DefaultClient)
+	memberships, err := project.AllMemberships(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(memberships) != 2 || memberships[0].ID != "m1" || memberships[1].ID != "m2" {
+		t.Errorf("Expected memberships m1 and m2 but saw %d", len(memberships))
+	}
+}
@@ -1,25 +1,180 @@
 package asana
 
+import (
+	"fmt"
+	"time"
+)
+
+// Portfolio is a collection of projects, and of other portfolios, whose
+// progress is tracked together.
 type Portfolio struct {
 	// Read-only. Globally unique ID of the object
 	ID string `json:"gid,omitempty"`
+
+	// The name of the portfolio.
+	Name string `json:"name,omitempty"`
+
+	// Color of the portfolio, one of the project colors.
+	Color string `json:"color,omitempty"`
+
+	// The current owner of the portfolio.
+	Owner *User `json:"owner,omitempty"`
+
+	// Read-only. The users that are members of the portfolio.
+	Members []*User `json:"members,omitempty"`
+
+	// Read-only. Array of Custom Field Settings applied to the portfolio.
+	CustomFieldSettings []*CustomFieldSetting `json:"custom_field_settings,omitempty"`
+
+	// Array of custom field values set on the portfolio.
+	CustomFields []*CustomFieldValue `json:"custom_fields,omitempty"`
+
+	// The days on which the portfolio starts and is due.
+	StartOn *Date `json:"start_on,omitempty"`
+	DueOn   *Date `json:"due_on,omitempty"`
+
+	// Read-only. The latest status update posted to the portfolio.
+	CurrentStatusUpdate *StatusUpdate `json:"current_status_update,omitempty"`
+
+	// Read-only. The time at which this object was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Read-only. A URL to the portfolio in the Asana web app.
+	PermalinkURL string `json:"permalink_url,omitempty"`
+
+	// True if the portfolio is public to its workspace members.
+	Public *bool `json:"public,omitempty"`
+
+	// Create-only. The workspace the portfolio belongs to.
+	Workspace *Workspace `json:"workspace,omitempty"`
 }
 
-// Portfolios returns a list of portfolios in this workspace
+// Portfolio item types
+const (
+	PortfolioItemProject   = "project"
+	PortfolioItemPortfolio = "portfolio"
+)
+
+// PortfolioItem is a project or portfolio contained in a portfolio
+type PortfolioItem struct {
+	// Read-only. Globally unique ID of the object
+	ID string `json:"gid,omitempty"`
+
+	// Read-only. The type of the item, project or portfolio
+	ResourceType string `json:"resource_type,omitempty"`
+
+	// Read-only. The name of the item.
+	Name string `json:"name,omitempty"`
+}
+
+type portfoliosQuery struct {
+	Workspace string `url:"workspace"`
+	Owner     string `url:"owner"`
+}
+
+// Portfolios returns a list of the portfolios in this workspace owned by
+// owner, a user GID or "me". The API only lists portfolios owned by the
+// authenticated user, except for service accounts.
 func (w *Workspace) Portfolios(
 	client *Client,
+	owner string,
 	options ...*Options,
 ) ([]*Portfolio, *NextPage, error) {
 	client.trace("Listing portfolios in %q", w.Name)
 
+	if owner == "" {
+		owner = "me"
+	}
+
 	var result []*Portfolio
 
-	o := &Options{
-		Workspace: w.ID,
-		Owner:     "me",
+	// Make the request
+	query := &portfoliosQuery{Workspace: w.ID, Owner: owner}
+	nextPage, err := client.get("/portfolios", query, &result, options...)
+	return result, nextPage, err
+}
+
+// AllPortfolios repeatedly pages through the portfolios of owner
+func (w *Workspace) AllPortfolios(client *Client, owner string, options ...*Options) ([]*Portfolio, error) {
+	var allPortfolios []*Portfolio
+	nextPage := &NextPage{}
+
+	var portfolios []*Portfolio
+	var err error
+
+	for nextPage != nil {
+		page := &Options{
+			Limit:  100,
+			Offset: nextPage.Offset,
+		}
+
+		allOptions := append([]*Options{page}, options...)
+		portfolios, nextPage, err = w.Portfolios(client, owner, allOptions...)
+		if err != nil {
+			return nil, err
+		}
+
+		allPortfolios = append(allPortfolios, portfolios...)
 	}
+	return allPortfolios, nil
+}
 
-	// Make the request
-	nextPage, err := client.get("/portfolios", nil, &result, append(options, o)...)
+// Fetch loads the full details for this portfolio
+func (p *Portfolio) Fetch(client *Client, opts ...*Options) error {
+	client.trace("Loading portfolio details for %q", p.Name)
+
+	_, err := client.get(fmt.Sprintf("/portfolios/%s", p.ID), nil, p, opts...)
+	return err
+}
+
+// Items returns the projects and portfolios in this portfolio
+func (p *Portfolio) Items(client *Client, opts ...*Options) ([]*PortfolioItem, *NextPage, error) {
+	client.trace("Listing items in portfolio %q", p.Name)
+
+	var result []*PortfolioItem
+	nextPage, err := client.get(fmt.Sprintf("/portfolios/%s/items", p.ID), nil, &result, opts...)
 	return result, nextPage, err
 }
+
+// AllItems repeatedly pages through the items of this portfolio
+func (p *Portfolio) AllItems(client *Client, options ...*Options) ([]*PortfolioItem, error) {
+	var allItems []*PortfolioItem
+	nextPage := &NextPage{}
+
+	var items []*PortfolioItem
+	var err error
+
+	for nextPage != nil {
+		page := &Options{
+			Limit:  100,
+			Offset: nextPage.Offset,
+		}
+
+		allOptions := append([]*Options{page}, options...)
+		items, nextPage, err = p.Items(client, allOptions...)
+		if err != nil {
+			return nil, err
+		}
+
+		allItems = append(allItems, items...)
+	}
+	return allItems, nil
+}
+
+type portfolioItemRequest struct {
+	Item string `json:"item"`
+}
+
+// AddItem adds a project or portfolio to this portfolio
+func (p *Portfolio) AddItem(client *Client, itemID string) error {
+	client.info("Adding item %s to portfolio %q", itemID, p.Name)
+
+	return client.post(fmt.Sprintf("/portfolios/%s/addItem", p.ID), &portfolioItemRequest{Item: itemID}, nil)
+}
+
+// RemoveItem removes a project or portfolio from this portfolio
+func (p *Portfolio) RemoveItem(client *Client, itemID string) error {
+	client.info("Removing item %s from portfolio %q", itemID, p.Name)
+
+	return client.post(fmt.Sprintf("/portfolios/%s/removeItem", p.ID), &portfolioItemRequest{Item: itemID}, nil)
+}
@@ -0,0 +1,79 @@
+package asana
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/h2non/gock"
+)
+
+func TestWorkspace_Portfolios(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://app.asana.com").
+		Get("/api/1.0/portfolios").
+		MatchParam("workspace", "1").
+		MatchParam("owner", "42").
+		Reply(200).
+		JSON(o{"data": []o{{"gid": "7", "name": "Roadmap"}}})
+
+	ws := &Workspace{ID: "1"}
+
+	client := NewClient(http.DefaultClient)
+	portfolios, _, err := ws.Portfolios(client, "42")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(portfolios) != 1 || portfolios[0].Name != "Roadmap" {
+		t.Errorf("Expected portfolio Roadmap but saw %v", portfolios)
+	}
+}
+
+func TestPortfolio_Items(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://app.asana.com").
+		Get("/api/1.0/portfolios/7/items").
+		Reply(200).
+		JSON(o{"data": []o{
+			{"gid": "1", "resource_type": "project", "name": "Website"},
+			{"gid": "2", "resource_type": "portfolio", "name": "Mobile"},
+		}})
+
+	portfolio := &Portfolio{ID: "7"}
+
+	client := NewClient(http.DefaultClient)
+	items, _, err := portfolio.Items(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("Expected 2 items but found %d", len(items))
+	}
+	if items[1].ResourceType != PortfolioItemPortfolio {
+		t.Errorf("Expected a portfolio but saw %s", items[1].ResourceType)
+	}
+}
+
+func TestPortfolio_AddItem(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://app.asana.com").
+		Post("/api/1.0/portfolios/7/addItem").
+		BodyString(`"item":"1"`).
+		Reply(200).
+		JSON(o{"data": o{}})
+
+	portfolio := &Portfolio{ID: "7"}
+
+	client := NewClient(http.DefaultClient)
+	if err := portfolio.AddItem(client, "1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if !gock.IsDone() {
+		t.Error("Expected the item to be added")
+	}
+}
@@ -2,6 +2,7 @@ package asana
 
 import (
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -103,6 +104,15 @@ type UpdateProjectRequest struct {
 
 	Owner        string                 `json:"owner,omitempty"`
 	CustomFields map[string]interface{} `json:"custom_fields,omitempty"`
+
+	// Clear names fields to remove, such as "notes" or "due_on".
+	Clear []string `json:"-"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (r *UpdateProjectRequest) MarshalJSON() ([]byte, error) {
+	type request UpdateProjectRequest
+	return marshalWithNulls((*request)(r), r.Clear)
 }
 
 // Project represents a prioritized list of tasks in Asana. It exists in a
@@ -167,6 +177,9 @@ type Project struct {
 	// The current owner of the project, may be null.
 	Owner *User `json:"owner,omitempty"`
 
+	// Read-only. The latest status update posted to the project.
+	CurrentStatusUpdate *StatusUpdate `json:"current_status_update,omitempty"`
+
 	// Create-only. The team that this project is shared with. This field only
 	// exists for projects in organizations.
 	Team *Team `json:"team,omitempty"`
@@ -203,6 +216,89 @@ func (p *Project) Update(client *Client, request *UpdateProjectRequest, opts ...
 	return err
 }
 
+// TaskCount holds the number of tasks in a project
+type TaskCount struct {
+	NumTasks           int `json:"num_tasks"`
+	NumCompletedTasks  int `json:"num_completed_tasks"`
+	NumIncompleteTasks int `json:"num_incomplete_tasks"`
+	NumMilestones      int `json:"num_milestones"`
+}
+
+// TaskCounts returns the number of tasks in the project
+func (p *Project) TaskCounts(client *Client) (*TaskCount, error) {
+	client.trace("Counting tasks in project %q", p.Name)
+
+	result := &TaskCount{}
+	_, err := client.get(fmt.Sprintf("/projects/%s/task_counts", p.ID), nil, result, &Options{
+		Fields: []string{"num_tasks", "num_completed_tasks", "num_incomplete_tasks", "num_milestones"},
+	})
+	return result, err
+}
+
+// Delete removes the project. Its tasks are kept if they belong to other
+// projects.
+func (p *Project) Delete(client *Client) error {
+	client.info("Deleting project %q", p.Name)
+
+	return client.delete(fmt.Sprintf("/projects/%s", p.ID))
+}
+
+// Parts of a project that can be copied by DuplicateProjectRequest.Include
+const (
+	DuplicateAllocations      = "allocations"
+	DuplicateForms            = "forms"
+	DuplicateMembers          = "members"
+	DuplicateNotes            = "notes"
+	DuplicateTaskAssignee     = "task_assignee"
+	DuplicateTaskAttachments  = "task_attachments"
+	DuplicateTaskDates        = "task_dates"
+	DuplicateTaskDependencies = "task_dependencies"
+	DuplicateTaskFollowers    = "task_followers"
+	DuplicateTaskNotes        = "task_notes"
+	DuplicateTaskProjects     = "task_projects"
+	DuplicateTaskSubtasks     = "task_subtasks"
+	DuplicateTaskTags         = "task_tags"
+)
+
+// ScheduleDates shifts the dates of a duplicated project. Set either DueOn or
+// StartOn to the date the new project should be due or start on; task dates
+// move by the same amount.
+type ScheduleDates struct {
+	ShouldSkipWeekends bool  `json:"should_skip_weekends"`
+	DueOn              *Date `json:"due_on,omitempty"`
+	StartOn            *Date `json:"start_on,omitempty"`
+}
+
+// DuplicateProjectRequest describes the copy made by Project.Duplicate
+type DuplicateProjectRequest struct {
+	Name          string         `json:"name"`
+	Team          string         `json:"team,omitempty"`
+	Include       []string       `json:"-"`
+	ScheduleDates *ScheduleDates `json:"schedule_dates,omitempty"`
+}
+
+// Duplicate starts copying the project. The copy is made asynchronously; the
+// returned job reports the new project once it has succeeded.
+func (p *Project) Duplicate(client *Client, request *DuplicateProjectRequest) (*Job, error) {
+	client.info("Duplicating project %q", p.Name)
+
+	// The API takes the included parts as a comma-separated list
+	m := map[string]any{"name": request.Name}
+	if request.Team != "" {
+		m["team"] = request.Team
+	}
+	if len(request.Include) > 0 {
+		m["include"] = strings.Join(request.Include, ",")
+	}
+	if request.ScheduleDates != nil {
+		m["schedule_dates"] = request.ScheduleDates
+	}
+
+	result := &Job{}
+	err := client.post(fmt.Sprintf("/projects/%s/duplicate", p.ID), m, result)
+	return result, err
+}
+
 // Projects returns a list of projects in this workspace
 func (w *Workspace) Projects(client *Client, options ...*Options) ([]*Project, *NextPage, error) {
 	client.trace("Listing projects in %q", w.Name)
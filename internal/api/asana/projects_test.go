@@ -0,0 +1,30 @@
+package asana
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/h2non/gock"
+)
+
+func TestProject_UpdateClear(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://app.asana.com").
+		Put("/api/1.0/projects/5").
+		BodyString(`"due_on":null,"name":"Website","notes":null,"owner":"100"`).
+		Reply(200).
+		JSON(o{"data": o{"gid": "5", "name": "Website"}})
+
+	project := &Project{ID: "5"}
+	request := &UpdateProjectRequest{Owner: "100", Clear: []string{"notes", "due_on"}}
+	request.Name = "Website"
+
+	client := NewClient(http.DefaultClient)
+	if err := project.Update(client, request); err != nil {
+		t.Fatal(err)
+	}
+	if !gock.IsDone() {
+		t.Error("Expected the cleared fields to be sent as null")
+	}
+}
@@ -67,7 +67,7 @@ func (p *Project) CreateSection(client *Client, section *SectionBase) (*Section,
 }
 
 type SectionInsertRequest struct {
-	Project       string `json:"project_gid"`
+	Project       string `json:"-"`
 	Section       string `json:"section"`
 	BeforeSection string `json:"before_section,omitempty"`
 	AfterSection  string `json:"after_section,omitempty"`
@@ -82,7 +82,7 @@ type SectionInsertRequest struct {
 func (p *Project) InsertSection(client *Client, request *SectionInsertRequest) error {
 	client.info("Moving section %s", request.Section)
 
-	err := client.post(fmt.Sprintf("projects/%s/sections/insert", p.ID), request, nil)
+	err := client.post(fmt.Sprintf("/projects/%s/sections/insert", p.ID), request, nil)
 	return err
 }
 
@@ -0,0 +1,124 @@
+package asana
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// StatusType is the overall state reported by a status update
+type StatusType string
+
+const (
+	StatusOnTrack  StatusType = "on_track"
+	StatusAtRisk   StatusType = "at_risk"
+	StatusOffTrack StatusType = "off_track"
+	StatusOnHold   StatusType = "on_hold"
+	StatusComplete StatusType = "complete"
+
+	// Only used for goals
+	StatusAchieved StatusType = "achieved"
+	StatusPartial  StatusType = "partial"
+	StatusMissed   StatusType = "missed"
+	StatusDropped  StatusType = "dropped"
+)
+
+// StatusUpdateBase contains the parts of StatusUpdate which are not related
+// to a specific instance
+type StatusUpdateBase struct {
+	// The title of the status update.
+	Title string `json:"title,omitempty"`
+
+	// The text content of the status update.
+	Text string `json:"text,omitempty"`
+
+	// The text content of the status update with formatting as HTML.
+	HTMLText string `json:"html_text,omitempty"`
+
+	// The type associated with the status update. This represents the
+	// current state of the object this update is associated with.
+	StatusType StatusType `json:"status_type,omitempty"`
+}
+
+// StatusUpdate is an update on the progress of a particular project,
+// portfolio or goal, sent out to all of its parent's followers.
+type StatusUpdate struct {
+	// Read-only. Globally unique ID of the object
+	ID string `json:"gid,omitempty"`
+
+	StatusUpdateBase
+
+	// Read-only. The type of the update, for example project_status_update
+	ResourceSubtype string `json:"resource_subtype,omitempty"`
+
+	// Read-only. The creator of the status update.
+	Author *User `json:"author,omitempty"`
+
+	// Read-only. The time at which this object was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Read-only. The time at which this object was last modified.
+	ModifiedAt *time.Time `json:"modified_at,omitempty"`
+
+	// Read-only. The project, portfolio or goal the update belongs to.
+	Parent *Project `json:"parent,omitempty"`
+}
+
+// CreateStatusUpdateRequest represents a request to post a status update
+type CreateStatusUpdateRequest struct {
+	StatusUpdateBase
+
+	// The project, portfolio or goal the update is posted on
+	Parent string `json:"parent"`
+}
+
+// Validate checks the status update data
+func (r *CreateStatusUpdateRequest) Validate() error {
+	if r.Parent == "" {
+		return errors.New("a status update needs a parent")
+	}
+	if r.StatusType == "" {
+		return errors.New("a status update needs a status type")
+	}
+	if r.Text != "" && r.HTMLText != "" {
+		return errors.New("a status update takes text or HTML text, not both")
+	}
+	return nil
+}
+
+type statusUpdatesQuery struct {
+	Parent string `url:"parent"`
+}
+
+// StatusUpdates lists the status updates of the project, newest first
+func (p *Project) StatusUpdates(client *Client, opts ...*Options) ([]*StatusUpdate, *NextPage, error) {
+	client.trace("Listing status updates of project %q", p.Name)
+
+	var result []*StatusUpdate
+	nextPage, err := client.get("/status_updates", &statusUpdatesQuery{Parent: p.ID}, &result, opts...)
+	return result, nextPage, err
+}
+
+// CreateStatusUpdate posts a status update
+func (c *Client) CreateStatusUpdate(request *CreateStatusUpdateRequest) (*StatusUpdate, error) {
+	c.info("Posting status update %q", request.Title)
+
+	result := &StatusUpdate{}
+	err := c.post("/status_updates", request, result)
+	return result, err
+}
+
+// Fetch loads the full details for this status update
+func (s *StatusUpdate) Fetch(client *Client, opts ...*Options) error {
+	client.trace("Loading status update %s", s.ID)
+
+	_, err := client.get(fmt.Sprintf("/status_updates/%s", s.ID), nil, s, opts...)
+	return err
+}
+
+// Delete removes the status update
+func (s *StatusUpdate) Delete(client *Client) error {
+	client.info("Deleting status update %s", s.ID)
+
+	return client.delete(fmt.Sprintf("/status_updates/%s", s.ID))
+}
@@ -0,0 +1,66 @@
+package asana
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/h2non/gock"
+)
+
+func TestProject_StatusUpdates(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://app.asana.com").
+		Get("/api/1.0/status_updates").
+		MatchParam("parent", "63627").
+		Reply(200).
+		JSON(o{"data": []o{{
+			"gid":              "12345",
+			"resource_subtype": "project_status_update",
+			"title":            "Week 12",
+			"status_type":      "at_risk",
+			"author":           o{"gid": "1", "name": "Jane Doe"},
+		}}})
+
+	project := &Project{ID: "63627"}
+
+	client := NewClient(http.DefaultClient)
+	updates, _, err := project.StatusUpdates(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(updates) != 1 {
+		t.Fatalf("Expected 1 status update but found %d", len(updates))
+	}
+
+	u := updates[0]
+	if u.StatusType != StatusAtRisk {
+		t.Errorf("Expected status type at_risk but saw %s", u.StatusType)
+	}
+	if u.Author == nil || u.Author.Name != "Jane Doe" {
+		t.Errorf("Expected author Jane Doe but saw %v", u.Author)
+	}
+}
+
+func TestCreateStatusUpdateRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		request CreateStatusUpdateRequest
+		wantErr bool
+	}{
+		{name: "valid", request: CreateStatusUpdateRequest{Parent: "1", StatusUpdateBase: StatusUpdateBase{StatusType: StatusOnTrack}}},
+		{name: "no parent", request: CreateStatusUpdateRequest{StatusUpdateBase: StatusUpdateBase{StatusType: StatusOnTrack}}, wantErr: true},
+		{name: "no type", request: CreateStatusUpdateRequest{Parent: "1"}, wantErr: true},
+		{name: "text and html", request: CreateStatusUpdateRequest{Parent: "1", StatusUpdateBase: StatusUpdateBase{StatusType: StatusOnTrack, Text: "a", HTMLText: "<body>a</body>"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -26,7 +26,7 @@ type StoryBase struct {
 
 	// Whether the story should be pinned on the resource.
 	// Note: This field is only present on comment and attachment stories.
-	IsPinned bool `json:"is_pinned,omitempty"`
+	IsPinned *bool `json:"is_pinned,omitempty"`
 }
 
 type Dates struct {
@@ -90,11 +90,23 @@ type StorySubtypeFields struct {
 	// Present for text_custom_field_changed, number_custom_field_changed, enum_custom_field_changed
 	OldTextValue   string     `json:"old_text_value,omitempty"`
 	NewTextValue   string     `json:"new_text_value,omitempty"`
-	OldNumberValue float64    `json:"old_number_value,omitempty"`
-	NewNumberValue float64    `json:"new_number_value,omitempty"`
+	OldNumberValue *float64   `json:"old_number_value,omitempty"`
+	NewNumberValue *float64   `json:"new_number_value,omitempty"`
 	OldEnumValue   *EnumValue `json:"old_enum_value,omitempty"`
 	NewEnumValue   *EnumValue `json:"new_enum_value,omitempty"`
 
+	// Present for multi_enum_custom_field_changed, date_custom_field_changed and
+	// people_custom_field_changed
+	OldMultiEnumValues []*EnumValue `json:"old_multi_enum_values,omitempty"`
+	NewMultiEnumValues []*EnumValue `json:"new_multi_enum_values,omitempty"`
+	OldDateValue       *DateValue   `json:"old_date_value,omitempty"`
+	NewDateValue       *DateValue   `json:"new_date_value,omitempty"`
+	OldPeopleValue     []*User      `json:"old_people_value,omitempty"`
+	NewPeopleValue     []*User      `json:"new_people_value,omitempty"`
+
+	// Present for all *_custom_field_changed stories
+	CustomField *CustomField `json:"custom_field,omitempty"`
+
 	// Present for duplicate_merged, marked_duplicate, duplicate_unmerged
 	DuplicateOf *Task `json:"duplicate_of,omitempty"`
 
@@ -165,6 +177,14 @@ func (t *Task) Stories(client *Client, opts ...*Options) ([]*Story, *NextPage, e
 	return result, nextPage, err
 }
 
+// Fetch loads the full details for this Story
+func (s *Story) Fetch(client *Client, opts ...*Options) error {
+	client.trace("Loading story details for %s", s.ID)
+
+	_, err := client.get(fmt.Sprintf("/stories/%s", s.ID), nil, s, opts...)
+	return err
+}
+
 // CreateComment adds a comment story to a task
 func (t *Task) CreateComment(client *Client, story *StoryBase) (*Story, error) {
 	client.info("Creating comment for task %q", t.Name)
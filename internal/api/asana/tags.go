@@ -106,3 +106,30 @@ func (w *Workspace) CreateTag(client *Client, tag *TagBase, options ...*Options)
 
 	return result, nil
 }
+
+// UpdateTagRequest represents a request to update a tag
+type UpdateTagRequest struct {
+	TagBase
+
+	// Clear names fields to remove, such as "color".
+	Clear []string `json:"-"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (r *UpdateTagRequest) MarshalJSON() ([]byte, error) {
+	return marshalWithNulls(r.TagBase, r.Clear)
+}
+
+// Update changes the given fields of the tag
+func (t *Tag) Update(client *Client, update *UpdateTagRequest, options ...*Options) error {
+	client.info("Updating tag %q\n", t.Name)
+
+	return client.put(fmt.Sprintf("/tags/%s", t.ID), update, t, options...)
+}
+
+// Delete removes the tag from the workspace and from all tasks
+func (t *Tag) Delete(client *Client) error {
+	client.info("Deleting tag %q\n", t.Name)
+
+	return client.delete(fmt.Sprintf("/tags/%s", t.ID))
+}
@@ -0,0 +1,78 @@
+package asana
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/h2non/gock"
+)
+
+func TestTag_Update(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://app.asana.com").
+		Put("/api/1.0/tags/7").
+		BodyString(`"color":"dark-blue"`).
+		Reply(200).
+		JSON(o{"data": o{"gid": "7", "name": "urgent", "color": "dark-blue"}})
+
+	tag := &Tag{ID: "7"}
+
+	client := NewClient(http.DefaultClient)
+	if err := tag.Update(client, &UpdateTagRequest{TagBase: TagBase{Color: "dark-blue"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if tag.Color != "dark-blue" || tag.Name != "urgent" {
+		t.Errorf("Expected the tag to be updated but saw %+v", tag.TagBase)
+	}
+}
+
+func TestTask_AddRemoveTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://app.asana.com").
+		Post("/api/1.0/tasks/1/addTag").
+		BodyString(`"tag":"7"`).
+		Reply(200).
+		JSON(o{"data": o{}})
+
+	gock.New("https://app.asana.com").
+		Post("/api/1.0/tasks/1/removeTag").
+		BodyString(`"tag":"7"`).
+		Reply(200).
+		JSON(o{"data": o{}})
+
+	task := &Task{ID: "1"}
+
+	client := NewClient(http.DefaultClient)
+	if err := task.AddTag(client, "7"); err != nil {
+		t.Fatal(err)
+	}
+	if err := task.RemoveTag(client, "7"); err != nil {
+		t.Fatal(err)
+	}
+
+	if !gock.IsDone() {
+		t.Error("Expected both requests to be made")
+	}
+}
+
+func TestTag_UpdateClear(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://app.asana.com").
+		Put("/api/1.0/tags/7").
+		BodyString(`"color":null,"name":"urgent"`).
+		Reply(200).
+		JSON(o{"data": o{"gid": "7", "name": "urgent"}})
+
+	tag := &Tag{ID: "7"}
+	request := &UpdateTagRequest{Clear: []string{"color"}}
+	request.Name = "urgent"
+
+	client := NewClient(http.DefaultClient)
+	if err := tag.Update(client, request); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -362,7 +362,7 @@ func (t *Task) RemoveProject(client *Client, projectID string) error {
 // SetParentRequest changes the parent of a task. Each task may only be a subtask of a single parent, or no parent task at all.
 // When using insert_before and insert_after, at most one of those two options can be specified, and they must already be subtasks of the parent.
 type SetParentRequest struct {
-	Parent       string // Required: The new parent of the task, or null for no parent.
+	Parent       string // Required: The new parent of the task, or empty for no parent.
 	InsertAfter  string // A subtask of the parent to insert the task after, or "-" to insert at the beginning of the list.
 	InsertBefore string // A subtask of the parent to insert the task before, or "-" to insert at the end of the list.
 }
@@ -375,6 +375,9 @@ func (t *Task) SetParent(client *Client, request *SetParentRequest) error {
 	m := map[string]interface{}{
 		"parent": request.Parent,
 	}
+	if request.Parent == "" {
+		m["parent"] = nil
+	}
 
 	switch {
 	case request.InsertAfter == "-":
@@ -421,6 +424,40 @@ func (t *Task) AddDependents(client *Client, request *AddDependentsRequest) erro
 	return err
 }
 
+// RemoveDependencies unlinks a set of dependencies from this task.
+func (t *Task) RemoveDependencies(client *Client, request *AddDependenciesRequest) error {
+	client.trace("Removing dependencies from task %q", t.ID)
+
+	err := client.post(fmt.Sprintf("/tasks/%s/removeDependencies", t.ID), request, nil)
+	return err
+}
+
+// RemoveDependents unlinks a set of dependents from this task.
+func (t *Task) RemoveDependents(client *Client, request *AddDependentsRequest) error {
+	client.trace("Removing dependents from task %q", t.ID)
+
+	err := client.post(fmt.Sprintf("/tasks/%s/removeDependents", t.ID), request, nil)
+	return err
+}
+
+type taskTagRequest struct {
+	Tag string `json:"tag"`
+}
+
+// AddTag adds a tag to this task
+func (t *Task) AddTag(client *Client, tagID string) error {
+	client.trace("Adding tag %s to task %q", tagID, t.ID)
+
+	return client.post(fmt.Sprintf("/tasks/%s/addTag", t.ID), &taskTagRequest{Tag: tagID}, nil)
+}
+
+// RemoveTag removes a tag from this task
+func (t *Task) RemoveTag(client *Client, tagID string) error {
+	client.trace("Removing tag %s from task %q", tagID, t.ID)
+
+	return client.post(fmt.Sprintf("/tasks/%s/removeTag", t.ID), &taskTagRequest{Tag: tagID}, nil)
+}
+
 // Tasks returns a list of tasks in this project
 func (p *Project) Tasks(client *Client, opts ...*Options) ([]*Task, *NextPage, error) {
 	client.trace("Listing tasks in %q", p.Name)
@@ -463,7 +500,7 @@ func (c *Client) CreateTask(task *CreateTaskRequest) (*Task, error) {
 }
 
 // CreateSubtask creates a new task as a subtask of this task
-func (t *Task) CreateSubtask(client *Client, task *Task) (*Task, error) {
+func (t *Task) CreateSubtask(client *Client, task *CreateTaskRequest) (*Task, error) {
 	client.info("Creating subtask %q", task.Name)
 
 	result := &Task{}
@@ -0,0 +1,45 @@
+package asana
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/h2non/gock"
+)
+
+func TestTask_Dependencies(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://app.asana.com").
+		Post("/api/1.0/tasks/100/addDependencies").
+		BodyString(`"dependencies":\["200","300"\]`).
+		Reply(200).
+		JSON(o{"data": o{}})
+	gock.New("https://app.asana.com").
+		Post("/api/1.0/tasks/100/addDependents").
+		BodyString(`"dependents":\["400"\]`).
+		Reply(200).
+		JSON(o{"data": o{}})
+	gock.New("https://app.asana.com").
+		Post("/api/1.0/tasks/100/removeDependencies").
+		BodyString(`"dependencies":\["200"\]`).
+		Reply(200).
+		JSON(o{"data": o{}})
+
+	task := &Task{ID: "100"}
+	client := NewClient(http.DefaultClient)
+
+	if err := task.AddDependencies(client, &AddDependenciesRequest{Dependencies: []string{"200", "300"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := task.AddDependents(client, &AddDependentsRequest{Dependents: []string{"400"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := task.RemoveDependencies(client, &AddDependenciesRequest{Dependencies: []string{"200"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if !gock.IsDone() {
+		t.Error("Expected all dependency requests to be sent")
+	}
+}
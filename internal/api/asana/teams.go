@@ -70,3 +70,79 @@ func (w *Workspace) AllTeams(client *Client, options ...*Options) ([]*Team, erro
 	}
 	return allTeams, nil
 }
+
+// TeamMembership is the membership of a user in a team
+type TeamMembership struct {
+	// Read-only. Globally unique ID of the object
+	ID string `json:"gid,omitempty"`
+
+	User *User `json:"user,omitempty"`
+	Team *Team `json:"team,omitempty"`
+
+	// Read-only. Whether the user is a guest of the team.
+	IsGuest bool `json:"is_guest,omitempty"`
+
+	// Read-only. Whether the user is an admin of the team.
+	IsAdmin bool `json:"is_admin,omitempty"`
+
+	// Read-only. Whether the user has limited access to the team.
+	IsLimitedAccess bool `json:"is_limited_access,omitempty"`
+}
+
+// Memberships returns the compact membership records of the team
+func (t *Team) Memberships(client *Client, options ...*Options) ([]*TeamMembership, *NextPage, error) {
+	client.trace("Listing memberships of team %q\n", t.Name)
+	var result []*TeamMembership
+
+	nextPage, err := client.get(fmt.Sprintf("/teams/%s/team_memberships", t.ID), nil, &result, options...)
+	return result, nextPage, err
+}
+
+// AllMemberships repeatedly pages through all memberships of the team
+func (t *Team) AllMemberships(client *Client, options ...*Options) ([]*TeamMembership, error) {
+	var allMemberships []*TeamMembership
+	nextPage := &NextPage{}
+
+	var memberships []*TeamMembership
+	var err error
+
+	for nextPage != nil {
+		page := &Options{
+			Limit:  100,
+			Offset: nextPage.Offset,
+		}
+
+		allOptions := append([]*Options{page}, options...)
+		memberships, nextPage, err = t.Memberships(client, allOptions...)
+		if err != nil {
+			return nil, err
+		}
+
+		allMemberships = append(allMemberships, memberships...)
+	}
+	return allMemberships, nil
+}
+
+type teamUserRequest struct {
+	User string `json:"user"`
+}
+
+// AddUser adds a user to the team. The user must be a member of the
+// team's organization.
+func (t *Team) AddUser(client *Client, userID string) (*TeamMembership, error) {
+	client.info("Adding user %s to team %q\n", userID, t.Name)
+
+	result := &TeamMembership{}
+	err := client.post(fmt.Sprintf("/teams/%s/addUser", t.ID), &teamUserRequest{User: userID}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RemoveUser removes a user from the team
+func (t *Team) RemoveUser(client *Client, userID string) error {
+	client.info("Removing user %s from team %q\n", userID, t.Name)
+
+	return client.post(fmt.Sprintf("/teams/%s/removeUser", t.ID), &teamUserRequest{User: userID}, nil)
+}
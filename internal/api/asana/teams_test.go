@@ -0,0 +1,74 @@
+package asana
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/h2non/gock"
+)
+
+func TestTeam_AllMemberships(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://app.asana.com").
+		Get("/api/1.0/teams/5/team_memberships").
+		MatchParam("offset", "abc").
+		Reply(200).
+		JSON(o{"data": []o{{"gid": "2", "user": o{"gid": "11", "name": "Bob"}, "is_guest": true}}})
+
+	gock.New("https://app.asana.com").
+		Get("/api/1.0/teams/5/team_memberships").
+		Reply(200).
+		JSON(o{
+			"data":      []o{{"gid": "1", "user": o{"gid": "10", "name": "Ann"}, "is_admin": true}},
+			"next_page": o{"offset": "abc"},
+		})
+
+	team := &Team{ID: "5"}
+
+	client := NewClient(http.DefaultClient)
+	memberships, err := team.AllMemberships(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(memberships) != 2 {
+		t.Fatalf("Expected 2 memberships but found %d", len(memberships))
+	}
+	if !memberships[0].IsAdmin || memberships[0].User.Name != "Ann" {
+		t.Errorf("Unexpected first membership %+v", memberships[0])
+	}
+	if !memberships[1].IsGuest {
+		t.Errorf("Expected the second member to be a guest")
+	}
+}
+
+func TestTeam_AddRemoveUser(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://app.asana.com").
+		Post("/api/1.0/teams/5/addUser").
+		BodyString(`"user":"10"`).
+		Reply(200).
+		JSON(o{"data": o{"gid": "1", "user": o{"gid": "10"}}})
+
+	gock.New("https://app.asana.com").
+		Post("/api/1.0/teams/5/removeUser").
+		BodyString(`"user":"10"`).
+		Reply(204)
+
+	team := &Team{ID: "5"}
+
+	client := NewClient(http.DefaultClient)
+	membership, err := team.AddUser(client, "10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if membership.User.ID != "10" {
+		t.Errorf("Expected membership of user 10 but saw %+v", membership)
+	}
+
+	if err := team.RemoveUser(client, "10"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -34,6 +34,24 @@ func (d *Date) UnmarshalJSON(value []byte) error {
 	return nil
 }
 
+// marshalWithNulls encodes v as JSON, sending the fields named in clear as
+// null. Update requests use it to clear fields that omitempty would drop.
+func marshalWithNulls(v any, clear []string) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(clear) == 0 {
+		return data, err
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for _, name := range clear {
+		fields[name] = nil
+	}
+	return json.Marshal(fields)
+}
+
 // Validator types have a Validate method which is called before posting the
 // data to the API
 type Validator interface {
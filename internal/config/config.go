@@ -19,7 +19,20 @@ type Config struct {
 	UserID    string           `mapstructure:"user_id"`
 	Workspace *asana.Workspace `mapstructure:"workspace"`
 	CreatedAt time.Time        `yaml:"created_at"`
-	mu        sync.RWMutex
+
+	// Markdown makes commands that write rich text use Markdown by default.
+	Markdown bool `mapstructure:"markdown"`
+
+	// Link holds the defaults of the .asana.yml nearest to the working
+	// directory, or nil if the directory is not linked.
+	Link *Link `mapstructure:"-"`
+
+	// globalWorkspace is the configured default workspace while Workspace
+	// holds linkedWorkspace, the workspace of the link.
+	globalWorkspace *asana.Workspace
+	linkedWorkspace *asana.Workspace
+
+	mu sync.RWMutex
 }
 
 const (
@@ -97,7 +110,7 @@ func (c *Config) Save() error {
 
 	viper.Set("username", c.Username)
 	viper.Set("user_id", c.UserID)
-	viper.Set("workspace", c.Workspace)
+	viper.Set("workspace", c.defaultWorkspace())
 	viper.Set("created_at", time.Now().Format(time.RFC3339))
 
 	if err := viper.WriteConfig(); err != nil {
@@ -131,9 +144,64 @@ func (c *Config) Load() error {
 		return fmt.Errorf("failed to decode config: %w", err)
 	}
 
+	return c.loadLink()
+}
+
+// loadLink discovers the .asana.yml for the working directory and lets its
+// workspace take precedence over the global default.
+func (c *Config) loadLink() error {
+	wd, err := os.Getwd()
+	if err != nil {
+		// Without a working directory there is no link to discover.
+		return nil
+	}
+
+	link, err := LoadLink(wd)
+	if err != nil {
+		return err
+	}
+	c.Link = link
+	c.applyLink()
+
 	return nil
 }
 
+// applyLink replaces Workspace with the workspace of the link, if any,
+// remembering the global default for Save and DefaultWorkspace.
+func (c *Config) applyLink() {
+	c.globalWorkspace, c.linkedWorkspace = nil, nil
+
+	link := c.Link
+	if link == nil || link.Workspace == nil || link.Workspace.ID == "" {
+		return
+	}
+
+	c.globalWorkspace = c.Workspace
+	c.linkedWorkspace = &asana.Workspace{
+		ID:   link.Workspace.ID,
+		Name: link.Workspace.Name,
+	}
+	c.Workspace = c.linkedWorkspace
+}
+
+// DefaultWorkspace returns the workspace configured as the global default,
+// which differs from Workspace inside a directory linked to another
+// workspace.
+func (c *Config) DefaultWorkspace() *asana.Workspace {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.defaultWorkspace()
+}
+
+func (c *Config) defaultWorkspace() *asana.Workspace {
+	// Unless a command replaced the linked workspace, it is not the default
+	if c.linkedWorkspace != nil && c.Workspace == c.linkedWorkspace {
+		return c.globalWorkspace
+	}
+	return c.Workspace
+}
+
 func (c *Config) Set(field string, value any) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -147,6 +215,7 @@ func (c *Config) Set(field string, value any) error {
 	if err := viper.Unmarshal(c); err != nil {
 		return fmt.Errorf("failed to update config struct: %w", err)
 	}
+	c.applyLink()
 
 	return viper.WriteConfig()
 }
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/timwehrle/asana/internal/api/asana"
@@ -200,3 +201,54 @@ func TestConfigErrors(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestConfigLinkedWorkspace(t *testing.T) {
+	// viper keeps the config file of earlier tests
+	viper.Reset()
+	t.Setenv(xdgConfigHome, t.TempDir())
+
+	global := &asana.Workspace{ID: "1", Name: "Global"}
+	require.NoError(t, (&Config{Username: "testuser", Workspace: global}).Save())
+
+	linked := t.TempDir()
+	link := &Link{
+		Path:      filepath.Join(linked, LinkFileName),
+		Workspace: &LinkRef{ID: "100", Name: "Acme"},
+	}
+	require.NoError(t, link.Save())
+
+	unlinked := t.TempDir()
+
+	loadIn := func(dir string) *Config {
+		t.Helper()
+		t.Chdir(dir)
+		cfg := &Config{}
+		require.NoError(t, cfg.Load())
+		return cfg
+	}
+
+	t.Run("Link overrides the workspace", func(t *testing.T) {
+		cfg := loadIn(linked)
+		assert.Equal(t, "100", cfg.Workspace.ID)
+		assert.Equal(t, global, cfg.DefaultWorkspace())
+	})
+
+	t.Run("Save keeps the global workspace", func(t *testing.T) {
+		cfg := loadIn(linked)
+		cfg.Username = "renamed"
+		require.NoError(t, cfg.Save())
+
+		reloaded := loadIn(unlinked)
+		assert.Equal(t, "renamed", reloaded.Username)
+		assert.Equal(t, "1", reloaded.Workspace.ID)
+	})
+
+	t.Run("Save writes a replaced workspace", func(t *testing.T) {
+		cfg := loadIn(linked)
+		cfg.Workspace = &asana.Workspace{ID: "2", Name: "Other"}
+		require.NoError(t, cfg.Save())
+
+		reloaded := loadIn(unlinked)
+		assert.Equal(t, "2", reloaded.Workspace.ID)
+	})
+}
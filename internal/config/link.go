@@ -0,0 +1,144 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/viper"
+)
+
+// LinkFileName is the name of the file linking a directory to an Asana project.
+const LinkFileName = ".asana.yml"
+
+// LinkRef references an Asana resource by its GID and a display name.
+type LinkRef struct {
+	ID   string `mapstructure:"gid"`
+	Name string `mapstructure:"name"`
+}
+
+// Link holds the per-directory defaults stored in a .asana.yml file. It is
+// discovered by walking up from the working directory, the same way git finds
+// its repository.
+type Link struct {
+	Workspace *LinkRef `mapstructure:"workspace"`
+	Project   *LinkRef `mapstructure:"project"`
+	Section   *LinkRef `mapstructure:"section"`
+	Assignee  string   `mapstructure:"assignee"`
+	Tags      []string `mapstructure:"tags"`
+
+	// BranchTemplate names the branches created by "asana git branch".
+	BranchTemplate string `mapstructure:"branch_template"`
+
+	// Path is the location of the file the link was read from.
+	Path string `mapstructure:"-"`
+}
+
+// FindLinkFile returns the path of the nearest .asana.yml, starting at dir and
+// walking up to the filesystem root. An empty string is returned when no file
+// is found.
+func FindLinkFile(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		path := filepath.Join(dir, LinkFileName)
+		info, err := os.Stat(path)
+		if err == nil && !info.IsDir() {
+			return path, nil
+		}
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("failed to inspect %s: %w", path, err)
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
+
+// LoadLink reads the nearest .asana.yml above dir. It returns nil without an
+// error when the directory is not linked.
+func LoadLink(dir string) (*Link, error) {
+	path, err := FindLinkFile(dir)
+	if err != nil || path == "" {
+		return nil, err
+	}
+
+	return ReadLink(path)
+}
+
+// ReadLink reads the link file at path.
+func ReadLink(path string) (*Link, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType("yaml")
+
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	link := &Link{}
+	if err := v.Unmarshal(link); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+	link.Path = path
+
+	return link, nil
+}
+
+// Save writes the link to Path, replacing any existing file.
+func (l *Link) Save() error {
+	if l.Path == "" {
+		return errors.New("link has no path")
+	}
+
+	v := viper.New()
+	v.SetConfigType("yaml")
+
+	setRef := func(key string, ref *LinkRef) {
+		if ref != nil && ref.ID != "" {
+			v.Set(key, map[string]string{"gid": ref.ID, "name": ref.Name})
+		}
+	}
+
+	setRef("workspace", l.Workspace)
+	setRef("project", l.Project)
+	setRef("section", l.Section)
+	if l.Assignee != "" {
+		v.Set("assignee", l.Assignee)
+	}
+	if len(l.Tags) > 0 {
+		v.Set("tags", l.Tags)
+	}
+	if l.BranchTemplate != "" {
+		v.Set("branch_template", l.BranchTemplate)
+	}
+
+	if err := v.WriteConfigAs(l.Path); err != nil {
+		return fmt.Errorf("failed to write %s: %w", l.Path, err)
+	}
+
+	return nil
+}
+
+// ProjectID returns the GID of the linked project, if any.
+func (l *Link) ProjectID() string {
+	if l == nil || l.Project == nil {
+		return ""
+	}
+	return l.Project.ID
+}
+
+// SectionID returns the GID of the linked section, if any.
+func (l *Link) SectionID() string {
+	if l == nil || l.Section == nil {
+		return ""
+	}
+	return l.Section.ID
+}
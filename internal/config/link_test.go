@@ -0,0 +1,64 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindLinkFile(t *testing.T) {
+	root := t.TempDir()
+	nested := filepath.Join(root, "a", "b", "c")
+	require.NoError(t, os.MkdirAll(nested, 0750))
+
+	t.Run("Not linked", func(t *testing.T) {
+		path, err := FindLinkFile(nested)
+		require.NoError(t, err)
+		assert.Empty(t, path)
+	})
+
+	t.Run("Walks up to the nearest file", func(t *testing.T) {
+		linkPath := filepath.Join(root, "a", LinkFileName)
+		require.NoError(t, os.WriteFile(linkPath, []byte("project:\n  gid: \"1\"\n"), 0600))
+
+		path, err := FindLinkFile(nested)
+		require.NoError(t, err)
+		assert.Equal(t, linkPath, path)
+	})
+}
+
+func TestLinkSaveAndLoad(t *testing.T) {
+	dir := t.TempDir()
+
+	link := &Link{
+		Path:      filepath.Join(dir, LinkFileName),
+		Workspace: &LinkRef{ID: "100", Name: "Acme"},
+		Project:   &LinkRef{ID: "200", Name: "Website"},
+		Section:   &LinkRef{ID: "300", Name: "Backlog"},
+		Assignee:  "me",
+		Tags:      []string{"frontend", "bug"},
+	}
+	require.NoError(t, link.Save())
+
+	loaded, err := LoadLink(filepath.Join(dir, "sub"))
+	require.NoError(t, err)
+	require.NotNil(t, loaded)
+
+	assert.Equal(t, link.Path, loaded.Path)
+	assert.Equal(t, link.Workspace, loaded.Workspace)
+	assert.Equal(t, link.Project, loaded.Project)
+	assert.Equal(t, link.Section, loaded.Section)
+	assert.Equal(t, "me", loaded.Assignee)
+	assert.Equal(t, []string{"frontend", "bug"}, loaded.Tags)
+	assert.Equal(t, "200", loaded.ProjectID())
+	assert.Equal(t, "300", loaded.SectionID())
+}
+
+func TestLinkNilAccessors(t *testing.T) {
+	var link *Link
+	assert.Empty(t, link.ProjectID())
+	assert.Empty(t, link.SectionID())
+}
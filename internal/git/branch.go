@@ -0,0 +1,75 @@
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+// DefaultBranchTemplate is used to name task branches when no template is
+// configured.
+const DefaultBranchTemplate = "{{.GID}}-{{.Slug}}"
+
+const maxSlugLength = 50
+
+var (
+	nonSlugChars = regexp.MustCompile(`[^a-z0-9]+`)
+	taskGIDRe    = regexp.MustCompile(`(?:^|[^0-9])([0-9]{10,})(?:[^0-9]|$)`)
+)
+
+// BranchData is the data available to branch name templates.
+type BranchData struct {
+	// GID is the task's globally unique ID.
+	GID string
+	// Name is the task name as entered in Asana.
+	Name string
+	// Slug is the lower-case, hyphenated form of Name.
+	Slug string
+}
+
+// Slugify turns s into a lower-case, hyphen separated string safe for use in
+// branch names. Long results are cut at a word boundary.
+func Slugify(s string) string {
+	slug := strings.Trim(nonSlugChars.ReplaceAllString(strings.ToLower(s), "-"), "-")
+
+	if len(slug) > maxSlugLength {
+		slug = slug[:maxSlugLength]
+		if i := strings.LastIndex(slug, "-"); i > 0 {
+			slug = slug[:i]
+		}
+	}
+
+	return strings.Trim(slug, "-")
+}
+
+// BranchName renders tmpl for the given task. An empty tmpl uses
+// DefaultBranchTemplate.
+func BranchName(tmpl, gid, name string) (string, error) {
+	if tmpl == "" {
+		tmpl = DefaultBranchTemplate
+	}
+
+	t, err := template.New("branch").Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return "", fmt.Errorf("invalid branch template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, BranchData{GID: gid, Name: name, Slug: Slugify(name)}); err != nil {
+		return "", fmt.Errorf("invalid branch template: %w", err)
+	}
+
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// TaskIDFromBranch extracts the task GID encoded in a branch name. Asana GIDs
+// are long numbers, so the first run of ten or more digits is used.
+func TaskIDFromBranch(branch string) (string, bool) {
+	m := taskGIDRe.FindStringSubmatch(branch)
+	if m == nil {
+		return "", false
+	}
+	return m[1], true
+}
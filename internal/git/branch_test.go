@@ -0,0 +1,66 @@
+package git
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "simple", input: "Fix login bug", want: "fix-login-bug"},
+		{name: "punctuation", input: "  [API] Add /users endpoint!  ", want: "api-add-users-endpoint"},
+		{name: "unicode", input: "Überarbeitung der Startseite", want: "berarbeitung-der-startseite"},
+		{name: "empty", input: "", want: ""},
+		{
+			name:  "long",
+			input: "Refactor the authentication middleware so that it supports multiple providers",
+			want:  "refactor-the-authentication-middleware-so-that-it",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Slugify(tt.input))
+		})
+	}
+}
+
+func TestBranchName(t *testing.T) {
+	name, err := BranchName("", "1204567890123", "Fix login bug")
+	require.NoError(t, err)
+	assert.Equal(t, "1204567890123-fix-login-bug", name)
+
+	name, err = BranchName("feature/{{.Slug}}-{{.GID}}", "1204567890123", "Fix login bug")
+	require.NoError(t, err)
+	assert.Equal(t, "feature/fix-login-bug-1204567890123", name)
+
+	_, err = BranchName("{{.Unknown}}", "1", "x")
+	require.Error(t, err)
+}
+
+func TestTaskIDFromBranch(t *testing.T) {
+	tests := []struct {
+		branch string
+		want   string
+		ok     bool
+	}{
+		{branch: "1204567890123-fix-login-bug", want: "1204567890123", ok: true},
+		{branch: "feature/fix-login-bug-1204567890123", want: "1204567890123", ok: true},
+		{branch: "release-2024", ok: false},
+		{branch: "main", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.branch, func(t *testing.T) {
+			got, ok := TaskIDFromBranch(tt.branch)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
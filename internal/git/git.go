@@ -0,0 +1,188 @@
+package git
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// Client runs git commands in a working directory. An empty Dir uses the
+// current working directory.
+type Client struct {
+	Dir string
+}
+
+// Commit is a single entry of the commit log.
+type Commit struct {
+	Sha     string
+	Subject string
+}
+
+// ShortSha returns the abbreviated commit hash.
+func (c Commit) ShortSha() string {
+	if len(c.Sha) > 7 {
+		return c.Sha[:7]
+	}
+	return c.Sha
+}
+
+// Error is returned when a git command exits unsuccessfully.
+type Error struct {
+	Args   []string
+	Stderr string
+	Err    error
+}
+
+func (e *Error) Error() string {
+	msg := strings.TrimSpace(e.Stderr)
+	if msg == "" {
+		msg = e.Err.Error()
+	}
+	return fmt.Sprintf("git %s: %s", e.Args[0], msg)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func (c *Client) run(args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.Command("git", args...)
+	cmd.Dir = c.Dir
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", &Error{Args: args, Stderr: stderr.String(), Err: err}
+	}
+
+	return strings.TrimRight(stdout.String(), "\n"), nil
+}
+
+// IsRepository reports whether Dir is inside a git work tree.
+func (c *Client) IsRepository() bool {
+	out, err := c.run("rev-parse", "--is-inside-work-tree")
+	return err == nil && out == "true"
+}
+
+// CurrentBranch returns the short name of the checked out branch.
+func (c *Client) CurrentBranch() (string, error) {
+	out, err := c.run("symbolic-ref", "--quiet", "--short", "HEAD")
+	if err != nil {
+		return "", errors.New("not on any branch")
+	}
+	return out, nil
+}
+
+// BranchExists reports whether a local branch with the given name exists.
+func (c *Client) BranchExists(name string) bool {
+	_, err := c.run("rev-parse", "--verify", "--quiet", "refs/heads/"+name)
+	return err == nil
+}
+
+// ValidateBranchName returns an error if name is not a valid branch name.
+func (c *Client) ValidateBranchName(name string) error {
+	if _, err := c.run("check-ref-format", "--branch", name); err != nil {
+		return fmt.Errorf("invalid branch name %q", name)
+	}
+	return nil
+}
+
+// CreateBranch creates a branch from HEAD and optionally checks it out.
+func (c *Client) CreateBranch(name string, checkout bool) error {
+	if checkout {
+		_, err := c.run("checkout", "-b", name)
+		return err
+	}
+
+	_, err := c.run("branch", name)
+	return err
+}
+
+// Checkout switches to an existing branch.
+func (c *Client) Checkout(name string) error {
+	_, err := c.run("checkout", name)
+	return err
+}
+
+// RevParse resolves a revision to its full commit hash.
+func (c *Client) RevParse(rev string) (string, error) {
+	return c.run("rev-parse", "--verify", "--quiet", rev+"^{commit}")
+}
+
+// Commits returns the commits in revRange, oldest first.
+func (c *Client) Commits(revRange string) ([]Commit, error) {
+	out, err := c.run("log", "--reverse", "--format=%H%x1f%s", revRange)
+	if err != nil {
+		return nil, err
+	}
+
+	var commits []Commit
+	for _, line := range strings.Split(out, "\n") {
+		sha, subject, ok := strings.Cut(line, "\x1f")
+		if !ok {
+			continue
+		}
+		commits = append(commits, Commit{Sha: sha, Subject: subject})
+	}
+
+	return commits, nil
+}
+
+// Config returns the value of a git configuration key, or an empty string if
+// it is not set.
+func (c *Client) Config(key string) string {
+	out, err := c.run("config", "--get", key)
+	if err != nil {
+		return ""
+	}
+	return out
+}
+
+// SetConfig stores a value in the repository's git configuration.
+func (c *Client) SetConfig(key, value string) error {
+	_, err := c.run("config", key, value)
+	return err
+}
+
+// RemoteURL returns the fetch URL of the named remote.
+func (c *Client) RemoteURL(name string) (string, error) {
+	return c.run("remote", "get-url", name)
+}
+
+// HooksDir returns the directory git runs hooks from.
+func (c *Client) HooksDir() (string, error) {
+	return c.run("rev-parse", "--path-format=absolute", "--git-path", "hooks")
+}
+
+// AddTrailer appends a "key: value" trailer to the commit message in file,
+// unless the message already carries the same trailer.
+func (c *Client) AddTrailer(file, key, value string) error {
+	_, err := c.run(
+		"interpret-trailers",
+		"--in-place",
+		"--if-exists", "addIfDifferent",
+		"--trailer", fmt.Sprintf("%s: %s", key, value),
+		file,
+	)
+	return err
+}
+
+// DefaultBranch guesses the branch new work is based on: the remote HEAD of
+// origin, or a local main or master branch.
+func (c *Client) DefaultBranch() (string, error) {
+	if out, err := c.run("symbolic-ref", "--quiet", "--short", "refs/remotes/origin/HEAD"); err == nil {
+		return out, nil
+	}
+
+	for _, name := range []string{"main", "master"} {
+		if c.BranchExists(name) {
+			return name, nil
+		}
+	}
+
+	return "", errors.New("cannot determine the default branch; use --base")
+}
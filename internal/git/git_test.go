@@ -0,0 +1,114 @@
+package git
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRepo(t *testing.T) *Client {
+	t.Helper()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	c := &Client{Dir: t.TempDir()}
+	for _, args := range [][]string{
+		{"init", "--quiet", "--initial-branch=main"},
+		{"config", "user.name", "Test"},
+		{"config", "user.email", "test@example.com"},
+		{"config", "commit.gpgsign", "false"},
+	} {
+		_, err := c.run(args...)
+		require.NoError(t, err)
+	}
+
+	return c
+}
+
+func commit(t *testing.T, c *Client, subject string) {
+	t.Helper()
+
+	_, err := c.run("commit", "--quiet", "--allow-empty", "-m", subject)
+	require.NoError(t, err)
+}
+
+func TestClient_Branches(t *testing.T) {
+	c := newTestRepo(t)
+	commit(t, c, "initial")
+
+	assert.True(t, c.IsRepository())
+
+	branch, err := c.CurrentBranch()
+	require.NoError(t, err)
+	assert.Equal(t, "main", branch)
+
+	require.NoError(t, c.ValidateBranchName("1204567890123-fix-bug"))
+	require.Error(t, c.ValidateBranchName("bad..name"))
+
+	require.NoError(t, c.CreateBranch("1204567890123-fix-bug", true))
+	assert.True(t, c.BranchExists("1204567890123-fix-bug"))
+
+	branch, err = c.CurrentBranch()
+	require.NoError(t, err)
+	assert.Equal(t, "1204567890123-fix-bug", branch)
+}
+
+func TestClient_Commits(t *testing.T) {
+	c := newTestRepo(t)
+	commit(t, c, "initial")
+	require.NoError(t, c.CreateBranch("topic", true))
+	commit(t, c, "first change")
+	commit(t, c, "second change")
+
+	commits, err := c.Commits("main..HEAD")
+	require.NoError(t, err)
+	require.Len(t, commits, 2)
+	assert.Equal(t, "first change", commits[0].Subject)
+	assert.Equal(t, "second change", commits[1].Subject)
+	assert.Len(t, commits[0].ShortSha(), 7)
+
+	head, err := c.RevParse("HEAD")
+	require.NoError(t, err)
+	assert.Equal(t, commits[1].Sha, head)
+
+	commits, err = c.Commits(head + "..HEAD")
+	require.NoError(t, err)
+	assert.Empty(t, commits)
+}
+
+func TestClient_Config(t *testing.T) {
+	c := newTestRepo(t)
+
+	assert.Empty(t, c.Config("branch.topic.asana-synced"))
+	require.NoError(t, c.SetConfig("branch.topic.asana-synced", "abc"))
+	assert.Equal(t, "abc", c.Config("branch.topic.asana-synced"))
+}
+
+func TestClient_AddTrailer(t *testing.T) {
+	c := newTestRepo(t)
+
+	file := filepath.Join(c.Dir, "COMMIT_EDITMSG")
+	require.NoError(t, os.WriteFile(file, []byte("Fix login bug\n"), 0600))
+
+	url := "https://app.asana.com/0/0/1204567890123"
+	require.NoError(t, c.AddTrailer(file, "Asana-Task", url))
+	require.NoError(t, c.AddTrailer(file, "Asana-Task", url))
+
+	content, err := os.ReadFile(file)
+	require.NoError(t, err)
+	assert.Equal(t, "Fix login bug\n\nAsana-Task: "+url+"\n", string(content))
+}
+
+func TestClient_HooksDir(t *testing.T) {
+	c := newTestRepo(t)
+
+	dir, err := c.HooksDir()
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(c.Dir, ".git", "hooks"), dir)
+}
@@ -0,0 +1,99 @@
+package git
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// scpLikeRe matches remote URLs in the scp-like syntax, e.g.
+// git@github.com:owner/repo.git.
+var scpLikeRe = regexp.MustCompile(`^(?:[^@/]+@)?([^:/]+):(.+)$`)
+
+// Remote is a repository on a hosting service, parsed from a remote URL.
+type Remote struct {
+	// Host is the host name of the service, e.g. github.com.
+	Host string
+	// Path is the path of the repository on the host, e.g. owner/repo.
+	Path string
+}
+
+// ParseRemoteURL parses an HTTPS, SSH or scp-like git remote URL.
+func ParseRemoteURL(raw string) (*Remote, error) {
+	raw = strings.TrimSpace(raw)
+
+	var host, path string
+	if strings.Contains(raw, "://") {
+		u, err := url.Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid remote URL %q: %w", raw, err)
+		}
+		host, path = u.Hostname(), u.Path
+	} else if m := scpLikeRe.FindStringSubmatch(raw); m != nil {
+		host, path = m[1], m[2]
+	}
+
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	if host == "" || !strings.Contains(path, "/") {
+		return nil, fmt.Errorf("cannot determine the repository from remote URL %q", raw)
+	}
+
+	// Hosts like ssh.github.com serve SSH for the web host github.com.
+	host = strings.TrimPrefix(host, "ssh.")
+
+	return &Remote{Host: host, Path: path}, nil
+}
+
+// URL returns the web URL of the repository.
+func (r *Remote) URL() string {
+	return "https://" + r.Host + "/" + r.Path
+}
+
+// BranchURL returns the web URL of a branch of the repository.
+func (r *Remote) BranchURL(branch string) string {
+	escaped := escapeBranch(branch)
+
+	switch {
+	case strings.Contains(r.Host, "gitlab"):
+		return r.URL() + "/-/tree/" + escaped
+	case strings.Contains(r.Host, "bitbucket"):
+		return r.URL() + "/src/" + escaped
+	default:
+		return r.URL() + "/tree/" + escaped
+	}
+}
+
+// PullRequestURL returns the web URL of a pull request, or merge request on
+// GitLab, of the repository.
+func (r *Remote) PullRequestURL(number int) string {
+	switch {
+	case strings.Contains(r.Host, "gitlab"):
+		return fmt.Sprintf("%s/-/merge_requests/%d", r.URL(), number)
+	case strings.Contains(r.Host, "bitbucket"):
+		return fmt.Sprintf("%s/pull-requests/%d", r.URL(), number)
+	default:
+		return fmt.Sprintf("%s/pull/%d", r.URL(), number)
+	}
+}
+
+// escapeBranch escapes a branch name for use in a URL path, keeping the
+// slashes of names like feature/login.
+func escapeBranch(branch string) string {
+	parts := strings.Split(branch, "/")
+	for i, p := range parts {
+		parts[i] = url.PathEscape(p)
+	}
+	return strings.Join(parts, "/")
+}
+
+// PushRemote returns the name of the remote a branch is tracked on, falling
+// back to origin.
+func (c *Client) PushRemote(branch string) string {
+	for _, key := range []string{"branch." + branch + ".pushRemote", "remote.pushDefault", "branch." + branch + ".remote"} {
+		if name := c.Config(key); name != "" && name != "." {
+			return name
+		}
+	}
+	return "origin"
+}
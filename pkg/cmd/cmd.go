@@ -56,6 +56,10 @@ func Main() ExitCode {
 	rootCmd.Flags().BoolP("version", "v", false, "Show asana version")
 
 	if err := rootCmd.Execute(); err != nil {
+		if errors.Is(err, cmdutils.ErrNoTask) {
+			return exitOK
+		}
+
 		if cmdutils.IsUserCancellation(err) {
 			if errors.Is(err, terminal.InterruptErr) {
 				fmt.Fprintf(stderr, "\n")
@@ -27,8 +27,9 @@ func NewCmdGet(f factory.Factory, runF func(*GetOptions) error) *cobra.Command {
 		Short: "Print the value of a given configuration key",
 		Example: heredoc.Doc(`
 				$ asana config get default-workspace
-				$ asana config get dw`),
-		ValidArgs: []string{"default-workspace", "dw"},
+				$ asana config get dw
+				$ asana config get markdown`),
+		ValidArgs: []string{"default-workspace", "dw", "markdown"},
 		Args:      cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if runF != nil {
@@ -52,12 +53,28 @@ func runConfigGet(opts *GetOptions, key string) error {
 			return err
 		}
 
+		workspace := cfg.DefaultWorkspace()
 		fmt.Fprintf(
 			opts.IO.Out,
 			"Default workspace is %s (%s)\n",
-			cs.Bold(cfg.Workspace.Name),
-			cfg.Workspace.ID,
+			cs.Bold(workspace.Name),
+			workspace.ID,
 		)
+		if cfg.Workspace != workspace {
+			fmt.Fprintf(
+				opts.IO.Out,
+				"This directory is linked to %s (%s)\n",
+				cs.Bold(cfg.Workspace.Name),
+				cfg.Workspace.ID,
+			)
+		}
+	case "markdown":
+		cfg, err := opts.Config()
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(opts.IO.Out, "Markdown by default is %s\n", cs.Bold(fmt.Sprint(cfg.Markdown)))
 	}
 
 	return nil
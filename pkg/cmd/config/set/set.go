@@ -32,12 +32,15 @@ func NewCmdConfigSet(f factory.Factory, runF func(*SetOptions) error) *cobra.Com
 	cmd := &cobra.Command{
 		Use:       "set <key>",
 		Short:     "Update configuration with a value",
-		ValidArgs: []string{"default-workspace", "dw"},
+		ValidArgs: []string{"default-workspace", "dw", "markdown"},
 		Args:      cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
 		Example: heredoc.Doc(`
 				# Set a configuration value
 				$ asana config set default-workspace
 				$ asana config set dw
+
+				# Write descriptions and comments in Markdown by default
+				$ asana config set markdown
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if runF != nil {
@@ -55,6 +58,8 @@ func runConfigSet(opts *SetOptions, key string) error {
 	switch key {
 	case "default-workspace", "dw":
 		return setDefaultWorkspace(opts)
+	case "markdown":
+		return setMarkdown(opts)
 	}
 
 	return nil
@@ -111,3 +116,34 @@ func setDefaultWorkspace(opts *SetOptions) error {
 
 	return nil
 }
+
+func setMarkdown(opts *SetOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	def := "No"
+	if cfg.Markdown {
+		def = "Yes"
+	}
+
+	enabled, err := opts.Prompter.Confirm("Write descriptions and comments in Markdown by default?", def)
+	if err != nil {
+		return fmt.Errorf("failed to confirm: %w", err)
+	}
+
+	if err := cfg.Set("markdown", enabled); err != nil {
+		return err
+	}
+
+	state := "disabled"
+	if enabled {
+		state = "enabled"
+	}
+	fmt.Fprintf(opts.IO.Out, "%s Markdown by default %s\n", cs.SuccessIcon, cs.Bold(state))
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package attach
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/fields/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type AttachOptions struct {
+	cmdutils.BaseOptions
+
+	Project   string
+	Field     string
+	Important bool
+	Before    string
+	After     string
+}
+
+func NewCmdAttach(f factory.Factory, runF func(*AttachOptions) error) *cobra.Command {
+	opts := &AttachOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "attach <project> <field>",
+		Short: "Add a custom field to a project",
+		Long: heredoc.Doc(`
+				Add a workspace custom field to a project. The project and field are given
+				by name or ID.
+
+				New fields are added at the end. Place them with --after or --before, which
+				take a field of the project, or "-" for the beginning (after) or end
+				(before) of the list.
+			`),
+		Example: heredoc.Doc(`
+				$ asana fields attach "Website" Priority --important
+				$ asana fields attach "Website" Estimate --after Priority
+				$ asana fields attach "Website" Estimate --after -
+			`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Project, opts.Field = args[0], args[1]
+
+			if opts.Before != "" && opts.After != "" {
+				return errors.New("specify only one of --before or --after")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runAttach(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Important, "important", false, "Show the field on tasks in list views")
+	cmd.Flags().StringVar(&opts.Before, "before", "", `Insert before this field, or "-" for the end`)
+	cmd.Flags().StringVar(&opts.After, "after", "", `Insert after this field, or "-" for the beginning`)
+
+	return cmd
+}
+
+func runAttach(opts *AttachOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	project, err := cmdutils.ResolveProject(client, cfg, opts.Prompter, opts.Project)
+	if err != nil {
+		return err
+	}
+
+	field, err := shared.ResolveField(client, cfg, opts.Field)
+	if err != nil {
+		return err
+	}
+
+	settings, err := shared.ProjectSettings(client, project)
+	if err != nil {
+		return err
+	}
+	for _, s := range settings {
+		if s.CustomField != nil && s.CustomField.ID == field.ID {
+			return fmt.Errorf("%s is already attached to %q", field.Name, project.Name)
+		}
+	}
+
+	before, err := settingRef(settings, project, opts.Before)
+	if err != nil {
+		return err
+	}
+	after, err := settingRef(settings, project, opts.After)
+	if err != nil {
+		return err
+	}
+
+	_, err = project.AddCustomFieldSetting(client, &asana.AddCustomFieldSettingRequest{
+		CustomField:  field.ID,
+		Important:    opts.Important,
+		InsertBefore: before,
+		InsertAfter:  after,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to attach custom field: %w", err)
+	}
+
+	opts.IO.Printf("%s Added %s to %s\n", cs.SuccessIcon, cs.Bold(field.Name), cs.Bold(project.Name))
+	return nil
+}
+
+// settingRef returns the GID of the custom field setting a --before or
+// --after value refers to, keeping "-" and empty values as they are.
+func settingRef(settings []*asana.CustomFieldSetting, project *asana.Project, ref string) (string, error) {
+	if ref == "" || ref == "-" {
+		return ref, nil
+	}
+
+	setting, err := shared.FindSetting(settings, project, ref)
+	if err != nil {
+		return "", err
+	}
+	return setting.ID, nil
+}
@@ -0,0 +1,75 @@
+package attach
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+func TestNewCmdAttach(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    AttachOptions
+		wantErr string
+	}{
+		{name: "plain", args: []string{"Website", "Priority"}, want: AttachOptions{Project: "Website", Field: "Priority"}},
+		{name: "important after", args: []string{"Website", "Priority", "--important", "--after", "-"}, want: AttachOptions{Project: "Website", Field: "Priority", Important: true, After: "-"}},
+		{name: "both positions", args: []string{"Website", "Priority", "--after", "A", "--before", "B"}, wantErr: "specify only one of --before or --after"},
+		{name: "missing field", args: []string{"Website"}, wantErr: "accepts 2 arg(s), received 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, _, _ := factory.NewTestFactory()
+
+			var got *AttachOptions
+			cmd := NewCmdAttach(f, func(opts *AttachOptions) error {
+				got = opts
+				return nil
+			})
+			cmd.SetArgs(tt.args)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.Project, got.Project)
+			assert.Equal(t, tt.want.Field, got.Field)
+			assert.Equal(t, tt.want.Important, got.Important)
+			assert.Equal(t, tt.want.After, got.After)
+		})
+	}
+}
+
+func TestSettingRef(t *testing.T) {
+	field := &asana.CustomField{ID: "10"}
+	field.Name = "Priority"
+	settings := []*asana.CustomFieldSetting{{ID: "s1", CustomField: field}}
+	project := &asana.Project{ID: "p1"}
+	project.Name = "Website"
+
+	for _, ref := range []string{"", "-"} {
+		got, err := settingRef(settings, project, ref)
+		require.NoError(t, err)
+		assert.Equal(t, ref, got)
+	}
+
+	got, err := settingRef(settings, project, "priority")
+	require.NoError(t, err)
+	assert.Equal(t, "s1", got)
+
+	got, err = settingRef(settings, project, "10")
+	require.NoError(t, err)
+	assert.Equal(t, "s1", got)
+
+	_, err = settingRef(settings, project, "Estimate")
+	require.EqualError(t, err, `custom field "Estimate" is not attached to project "Website"`)
+}
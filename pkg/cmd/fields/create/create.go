@@ -0,0 +1,343 @@
+package create
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type CreateOptions struct {
+	cmdutils.BaseOptions
+
+	File      string
+	Project   string
+	Important bool
+	Spec      FieldSpec
+}
+
+// FieldSpec describes a custom field to create. It is read from flags or a
+// YAML file.
+type FieldSpec struct {
+	Name                string       `mapstructure:"name"`
+	Type                string       `mapstructure:"type"`
+	Description         string       `mapstructure:"description"`
+	Format              string       `mapstructure:"format"`
+	Precision           *int         `mapstructure:"precision"`
+	CurrencyCode        string       `mapstructure:"currency_code"`
+	CustomLabel         string       `mapstructure:"custom_label"`
+	CustomLabelPosition string       `mapstructure:"custom_label_position"`
+	Options             []OptionSpec `mapstructure:"options"`
+}
+
+// OptionSpec is an option of an enum or multi_enum field.
+type OptionSpec struct {
+	Name  string `mapstructure:"name"`
+	Color string `mapstructure:"color"`
+}
+
+var fieldTypes = []string{
+	string(asana.FieldTypeText),
+	string(asana.FieldTypeNumber),
+	string(asana.FieldTypeEnum),
+	string(asana.FieldTypeMultiEnum),
+	string(asana.FieldTypeDate),
+	string(asana.FieldTypeBoolean),
+	string(asana.FieldTypePeople),
+}
+
+var numberFormats = []string{asana.None, asana.Currency, asana.Identifier, asana.Percentage, asana.Custom}
+
+// colors are the colors Asana accepts for enum options.
+var colors = []string{
+	"none", "red", "orange", "yellow-orange", "yellow", "yellow-green", "green",
+	"blue-green", "aqua", "blue", "indigo", "purple", "magenta", "hot-pink",
+	"pink", "cool-gray",
+}
+
+func NewCmdCreate(f factory.Factory, runF func(*CreateOptions) error) *cobra.Command {
+	opts := &CreateOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	var (
+		options   []string
+		precision int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a custom field",
+		Long: heredoc.Docf(`
+				Create a custom field in your workspace, or with --project a field local to
+				that project.
+
+				Enum and multi_enum fields need options, given with --option as a name and
+				an optional color separated by a colon. Number fields take a --format of
+				none, currency, identifier, percentage or custom, and a --precision.
+
+				The field can also be described in a YAML file, which flags override:
+
+				%[1]s%[1]s%[1]syaml
+				name: Priority
+				type: enum
+				description: How urgent the task is
+				options:
+				  - name: High
+				    color: red
+				  - name: Low
+				    color: green
+				%[1]s%[1]s%[1]s
+			`, "`"),
+		Example: heredoc.Doc(`
+				$ asana fields create --name Priority --type enum --option High:red --option Low:green
+				$ asana fields create --name Estimate --type number --precision 1 --project "Website"
+				$ asana fields create --file priority.yml
+			`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spec := FieldSpec{}
+			if opts.File != "" {
+				var err error
+				if spec, err = readSpec(opts.File); err != nil {
+					return err
+				}
+			}
+			if cmd.Flags().Changed("name") {
+				spec.Name = opts.Spec.Name
+			}
+			if cmd.Flags().Changed("type") {
+				spec.Type = opts.Spec.Type
+			}
+			if cmd.Flags().Changed("description") {
+				spec.Description = opts.Spec.Description
+			}
+			if cmd.Flags().Changed("format") {
+				spec.Format = opts.Spec.Format
+			}
+			if cmd.Flags().Changed("precision") {
+				spec.Precision = &precision
+			}
+			if cmd.Flags().Changed("currency") {
+				spec.CurrencyCode = opts.Spec.CurrencyCode
+			}
+			if cmd.Flags().Changed("label") {
+				spec.CustomLabel = opts.Spec.CustomLabel
+			}
+			if cmd.Flags().Changed("label-position") {
+				spec.CustomLabelPosition = opts.Spec.CustomLabelPosition
+			}
+			if len(options) > 0 {
+				spec.Options = parseOptions(options)
+			}
+
+			if err := spec.Validate(); err != nil {
+				return err
+			}
+			if opts.Important && opts.Project == "" {
+				return errors.New("--important requires --project")
+			}
+			opts.Spec = spec
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runCreate(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Spec.Name, "name", "n", "", "Field name")
+	cmd.Flags().StringVarP(&opts.Spec.Type, "type", "t", "", "Field type: "+strings.Join(fieldTypes, ", "))
+	cmd.Flags().StringVarP(&opts.Spec.Description, "description", "d", "", "Field description")
+	cmd.Flags().StringArrayVarP(&options, "option", "o", nil, "Option of an enum field as <name>[:<color>] (repeatable)")
+	cmd.Flags().StringVar(&opts.Spec.Format, "format", "", "Number format: "+strings.Join(numberFormats, ", "))
+	cmd.Flags().IntVar(&precision, "precision", 0, "Decimal places of a number field (0-6)")
+	cmd.Flags().StringVar(&opts.Spec.CurrencyCode, "currency", "", "ISO 4217 currency code of a currency field")
+	cmd.Flags().StringVar(&opts.Spec.CustomLabel, "label", "", "Label of a number field with custom format")
+	cmd.Flags().StringVar(&opts.Spec.CustomLabelPosition, "label-position", "", "Position of the custom label: prefix or suffix")
+	cmd.Flags().StringVarP(&opts.File, "file", "F", "", "Read the field from a YAML file")
+	cmd.Flags().StringVarP(&opts.Project, "project", "p", "", "Create the field local to a project")
+	cmd.Flags().BoolVar(&opts.Important, "important", false, "Show the project-local field on tasks in list views")
+
+	return cmd
+}
+
+func runCreate(opts *CreateOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	base, enumOptions := opts.Spec.request()
+
+	if opts.Project == "" {
+		field, err := client.CreateCustomField(&asana.CreateCustomFieldRequest{
+			CustomFieldBase: base,
+			Workspace:       cfg.Workspace.ID,
+			EnumOptions:     enumOptions,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create custom field: %w", err)
+		}
+
+		opts.IO.Printf("%s Created custom field %s %s\n", cs.SuccessIcon, cs.Bold(field.Name), cs.Gray(field.ID))
+		return nil
+	}
+
+	project, err := cmdutils.ResolveProject(client, cfg, opts.Prompter, opts.Project)
+	if err != nil {
+		return err
+	}
+
+	setting, err := project.AddProjectLocalCustomField(client, &asana.AddProjectLocalCustomFieldRequest{
+		CustomField: asana.ProjectLocalCustomField{
+			CustomFieldBase: base,
+			EnumOptions:     enumOptions,
+		},
+		Important: opts.Important,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create custom field: %w", err)
+	}
+
+	id := ""
+	if setting.CustomField != nil {
+		id = setting.CustomField.ID
+	}
+	opts.IO.Printf("%s Created custom field %s in %s %s\n", cs.SuccessIcon, cs.Bold(base.Name), cs.Bold(project.Name), cs.Gray(id))
+	return nil
+}
+
+func readSpec(path string) (FieldSpec, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType("yaml")
+
+	spec := FieldSpec{}
+	if err := v.ReadInConfig(); err != nil {
+		return spec, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if err := v.Unmarshal(&spec); err != nil {
+		return spec, fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+
+	return spec, nil
+}
+
+// parseOptions parses <name>[:<color>] values. The part after the last colon
+// is only taken as the color if it names one, so option names may contain
+// colons.
+func parseOptions(values []string) []OptionSpec {
+	options := make([]OptionSpec, 0, len(values))
+	for _, v := range values {
+		option := OptionSpec{Name: strings.TrimSpace(v)}
+		if i := strings.LastIndex(v, ":"); i >= 0 {
+			if color := strings.ToLower(strings.TrimSpace(v[i+1:])); slices.Contains(colors, color) {
+				option = OptionSpec{Name: strings.TrimSpace(v[:i]), Color: color}
+			}
+		}
+		options = append(options, option)
+	}
+	return options
+}
+
+// Validate checks that the spec describes a valid field of its type.
+func (s *FieldSpec) Validate() error {
+	if strings.TrimSpace(s.Name) == "" {
+		return errors.New("field name cannot be empty")
+	}
+	if s.Type == "" {
+		return errors.New("field type is required: " + strings.Join(fieldTypes, ", "))
+	}
+	if err := cmdutils.ValidateStringEnum("type", s.Type, fieldTypes); err != nil {
+		return err
+	}
+
+	isEnum := s.Type == string(asana.FieldTypeEnum) || s.Type == string(asana.FieldTypeMultiEnum)
+	if isEnum && len(s.Options) == 0 {
+		return fmt.Errorf("%s fields need at least one option", s.Type)
+	}
+	if !isEnum && len(s.Options) > 0 {
+		return fmt.Errorf("%s fields cannot have options", s.Type)
+	}
+	for _, o := range s.Options {
+		if strings.TrimSpace(o.Name) == "" {
+			return errors.New("option name cannot be empty")
+		}
+		if o.Color != "" && !slices.Contains(colors, o.Color) {
+			return fmt.Errorf("invalid color %q for option %q; valid colors are: %s", o.Color, o.Name, strings.Join(colors, ", "))
+		}
+	}
+
+	if s.Type != string(asana.FieldTypeNumber) {
+		if s.Format != "" || s.Precision != nil || s.CurrencyCode != "" || s.CustomLabel != "" {
+			return fmt.Errorf("format and precision only apply to number fields")
+		}
+		return nil
+	}
+
+	if s.Format != "" {
+		if err := cmdutils.ValidateStringEnum("format", s.Format, numberFormats); err != nil {
+			return err
+		}
+	}
+	if s.Precision != nil && (*s.Precision < 0 || *s.Precision > 6) {
+		return fmt.Errorf("precision must be between 0 and 6")
+	}
+	if s.Format == asana.Currency && s.CurrencyCode == "" {
+		return errors.New("currency fields need a currency code")
+	}
+	if s.CurrencyCode != "" && s.Format != asana.Currency {
+		return errors.New("a currency code requires the currency format")
+	}
+	if s.CustomLabel != "" && s.Format != asana.Custom {
+		return errors.New("a label requires the custom format")
+	}
+	if s.CustomLabelPosition != "" {
+		if err := cmdutils.ValidateStringEnum("label-position", s.CustomLabelPosition, []string{"prefix", "suffix"}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *FieldSpec) request() (asana.CustomFieldBase, []*asana.EnumValueBase) {
+	base := asana.CustomFieldBase{
+		Name:                strings.TrimSpace(s.Name),
+		Description:         s.Description,
+		ResourceSubtype:     asana.FieldType(s.Type),
+		Format:              asana.Format(s.Format),
+		Precision:           s.Precision,
+		CurrencyCode:        s.CurrencyCode,
+		CustomLabel:         s.CustomLabel,
+		CustomLabelPosition: asana.LabelPosition(s.CustomLabelPosition),
+	}
+
+	options := make([]*asana.EnumValueBase, 0, len(s.Options))
+	for _, o := range s.Options {
+		options = append(options, &asana.EnumValueBase{Name: strings.TrimSpace(o.Name), Color: o.Color})
+	}
+
+	return base, options
+}
@@ -0,0 +1,93 @@
+package create
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+func TestNewCmdCreate(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "priority.yml")
+	require.NoError(t, os.WriteFile(file, []byte(`
+name: Priority
+type: enum
+description: How urgent the task is
+options:
+  - name: High
+    color: red
+  - name: Low
+`), 0600))
+
+	precision := 1
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    FieldSpec
+		wantErr string
+	}{
+		{
+			name: "enum with colors",
+			args: []string{"--name", "Priority", "--type", "enum", "-o", "High:red", "-o", "Ratio 1:2", "-o", "Low:Green"},
+			want: FieldSpec{Name: "Priority", Type: "enum", Options: []OptionSpec{
+				{Name: "High", Color: "red"}, {Name: "Ratio 1:2"}, {Name: "Low", Color: "green"},
+			}},
+		},
+		{
+			name: "number",
+			args: []string{"--name", "Estimate", "--type", "number", "--precision", "1", "--format", "custom", "--label", "h", "--label-position", "suffix"},
+			want: FieldSpec{Name: "Estimate", Type: "number", Precision: &precision, Format: "custom", CustomLabel: "h", CustomLabelPosition: "suffix"},
+		},
+		{
+			name: "file",
+			args: []string{"--file", file},
+			want: FieldSpec{Name: "Priority", Type: "enum", Description: "How urgent the task is", Options: []OptionSpec{
+				{Name: "High", Color: "red"}, {Name: "Low"},
+			}},
+		},
+		{
+			name: "flags override file",
+			args: []string{"--file", file, "--name", "Urgency", "--type", "multi_enum"},
+			want: FieldSpec{Name: "Urgency", Type: "multi_enum", Description: "How urgent the task is", Options: []OptionSpec{
+				{Name: "High", Color: "red"}, {Name: "Low"},
+			}},
+		},
+		{name: "missing name", args: []string{"--type", "text"}, wantErr: "field name cannot be empty"},
+		{name: "invalid type", args: []string{"--name", "X", "--type", "list"}, wantErr: "invalid value \"list\" for flag --type; valid values are: boolean, date, enum, multi_enum, number, people, text"},
+		{name: "enum without options", args: []string{"--name", "X", "--type", "enum"}, wantErr: "enum fields need at least one option"},
+		{name: "options on text", args: []string{"--name", "X", "--type", "text", "-o", "A"}, wantErr: "text fields cannot have options"},
+		{name: "precision on date", args: []string{"--name", "X", "--type", "date", "--precision", "2"}, wantErr: "format and precision only apply to number fields"},
+		{name: "precision range", args: []string{"--name", "X", "--type", "number", "--precision", "7"}, wantErr: "precision must be between 0 and 6"},
+		{name: "currency without code", args: []string{"--name", "X", "--type", "number", "--format", "currency"}, wantErr: "currency fields need a currency code"},
+		{name: "important without project", args: []string{"--name", "X", "--type", "text", "--important"}, wantErr: "--important requires --project"},
+		{name: "missing file", args: []string{"--file", filepath.Join(dir, "missing.yml")}, wantErr: "failed to read " + filepath.Join(dir, "missing.yml") + ": open " + filepath.Join(dir, "missing.yml") + ": no such file or directory"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, _, _ := factory.NewTestFactory()
+
+			var got *CreateOptions
+			cmd := NewCmdCreate(f, func(opts *CreateOptions) error {
+				got = opts
+				return nil
+			})
+			cmd.SetArgs(tt.args)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.Spec)
+		})
+	}
+}
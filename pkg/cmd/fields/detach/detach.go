@@ -0,0 +1,103 @@
+package detach
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/pkg/cmd/fields/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type DetachOptions struct {
+	cmdutils.BaseOptions
+
+	Project string
+	Field   string
+	Yes     bool
+}
+
+func NewCmdDetach(f factory.Factory, runF func(*DetachOptions) error) *cobra.Command {
+	opts := &DetachOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "detach <project> <field>",
+		Short: "Remove a custom field from a project",
+		Long: heredoc.Doc(`
+				Remove a custom field from a project. Tasks of the project lose their values
+				of the field unless another of their projects has it.
+			`),
+		Example: heredoc.Doc(`
+				$ asana fields detach "Website" Estimate
+			`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Project, opts.Field = args[0], args[1]
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runDetach(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func runDetach(opts *DetachOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	project, err := cmdutils.ResolveProject(client, cfg, opts.Prompter, opts.Project)
+	if err != nil {
+		return err
+	}
+
+	settings, err := shared.ProjectSettings(client, project)
+	if err != nil {
+		return err
+	}
+	setting, err := shared.FindSetting(settings, project, opts.Field)
+	if err != nil {
+		return err
+	}
+	field := setting.CustomField
+
+	if !opts.Yes {
+		confirmed, err := opts.Prompter.Confirm(
+			fmt.Sprintf("Remove %s from %s?", field.Name, project.Name), "No")
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return nil
+		}
+	}
+
+	if err := project.RemoveCustomFieldSetting(client, field.ID); err != nil {
+		return fmt.Errorf("failed to detach custom field: %w", err)
+	}
+
+	opts.IO.Printf("%s Removed %s from %s\n", cs.SuccessIcon, cs.Bold(field.Name), cs.Bold(project.Name))
+	return nil
+}
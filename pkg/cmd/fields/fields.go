@@ -0,0 +1,28 @@
+package fields
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/pkg/cmd/fields/attach"
+	"github.com/timwehrle/asana/pkg/cmd/fields/create"
+	"github.com/timwehrle/asana/pkg/cmd/fields/detach"
+	"github.com/timwehrle/asana/pkg/cmd/fields/list"
+	"github.com/timwehrle/asana/pkg/cmd/fields/view"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+func NewCmdFields(f factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fields <subcommand>",
+		Aliases: []string{"field"},
+		Short:   "Manage custom fields",
+		Long:    "Perform operations related to the custom fields of your workspace and projects.",
+	}
+
+	cmd.AddCommand(list.NewCmdList(f, nil))
+	cmd.AddCommand(view.NewCmdView(f, nil))
+	cmd.AddCommand(create.NewCmdCreate(f, nil))
+	cmd.AddCommand(attach.NewCmdAttach(f, nil))
+	cmd.AddCommand(detach.NewCmdDetach(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,124 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/fields/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type ListOptions struct {
+	cmdutils.BaseOptions
+
+	Project string
+	JSON    bool
+}
+
+func NewCmdList(f factory.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List custom fields",
+		Long: heredoc.Doc(`
+				List the custom fields of your workspace, or with --project the fields
+				attached to a project in order. Important fields are marked with a star.
+			`),
+		Example: heredoc.Doc(`
+				$ asana fields list
+				$ asana fields list --project "Website"
+			`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runList(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Project, "project", "p", "", "List the fields attached to a project")
+	cmd.Flags().BoolVar(&opts.JSON, "json", false, "Output as JSON")
+
+	return cmd
+}
+
+func runList(opts *ListOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	var (
+		title    string
+		settings []*asana.CustomFieldSetting
+	)
+	if opts.Project != "" {
+		project, err := cmdutils.ResolveProject(client, cfg, opts.Prompter, opts.Project)
+		if err != nil {
+			return err
+		}
+		if settings, err = shared.ProjectSettings(client, project); err != nil {
+			return err
+		}
+		title = project.Name
+	} else {
+		ws := &asana.Workspace{ID: cfg.Workspace.ID}
+		fields, err := ws.AllCustomFields(client, &asana.Options{Fields: []string{"name", "resource_subtype"}})
+		if err != nil {
+			return fmt.Errorf("failed to fetch custom fields: %w", err)
+		}
+		for _, f := range fields {
+			settings = append(settings, &asana.CustomFieldSetting{CustomField: f})
+		}
+		title = cfg.Workspace.Name
+	}
+
+	if opts.JSON {
+		fields := make([]*asana.CustomField, 0, len(settings))
+		for _, s := range settings {
+			fields = append(fields, s.CustomField)
+		}
+		enc := json.NewEncoder(opts.IO.Out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(fields)
+	}
+
+	opts.IO.Printf("\nCustom fields in %s:\n\n", cs.Bold(title))
+	if len(settings) == 0 {
+		opts.IO.Println("No custom fields found")
+		return nil
+	}
+
+	for i, s := range settings {
+		important := ""
+		if s.Important {
+			important = " ★"
+		}
+		opts.IO.Printf("%d. %s%s %s %s\n", i+1, cs.Bold(s.CustomField.Name), important,
+			s.CustomField.ResourceSubtype, cs.Gray(s.CustomField.ID))
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/internal/config"
+)
+
+// FieldFields are the custom field fields needed to describe a field.
+var FieldFields = []string{
+	"name", "resource_subtype", "description", "format", "precision",
+	"currency_code", "custom_label", "custom_label_position",
+	"enum_options.name", "enum_options.color", "enum_options.enabled",
+	"is_global_to_workspace",
+}
+
+// ResolveField returns the workspace custom field identified by nameOrID,
+// matching names case-insensitively.
+func ResolveField(client *asana.Client, cfg *config.Config, nameOrID string) (*asana.CustomField, error) {
+	ws := &asana.Workspace{ID: cfg.Workspace.ID}
+	fields, err := ws.AllCustomFields(client, &asana.Options{Fields: []string{"name", "resource_subtype"}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch custom fields: %w", err)
+	}
+
+	var found *asana.CustomField
+	for _, f := range fields {
+		if f.ID == nameOrID {
+			return f, nil
+		}
+		if strings.EqualFold(f.Name, nameOrID) {
+			if found != nil {
+				return nil, fmt.Errorf("custom field name %q is ambiguous: use the field ID", nameOrID)
+			}
+			found = f
+		}
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("custom field %q not found in workspace", nameOrID)
+	}
+	return found, nil
+}
+
+// ProjectSettings returns the custom field settings of a project in the order
+// they are shown in Asana.
+func ProjectSettings(client *asana.Client, project *asana.Project) ([]*asana.CustomFieldSetting, error) {
+	p := &asana.Project{ID: project.ID}
+	err := p.Fetch(client, &asana.Options{Fields: []string{
+		"custom_field_settings.is_important",
+		"custom_field_settings.custom_field.name",
+		"custom_field_settings.custom_field.resource_subtype",
+	}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch custom fields of project %q: %w", project.Name, err)
+	}
+
+	return p.CustomFieldSettings, nil
+}
+
+// FindSetting returns the setting of the custom field identified by nameOrID.
+func FindSetting(settings []*asana.CustomFieldSetting, project *asana.Project, nameOrID string) (*asana.CustomFieldSetting, error) {
+	for _, s := range settings {
+		if s.CustomField == nil {
+			continue
+		}
+		if s.CustomField.ID == nameOrID || strings.EqualFold(s.CustomField.Name, nameOrID) {
+			return s, nil
+		}
+	}
+	return nil, fmt.Errorf("custom field %q is not attached to project %q", nameOrID, project.Name)
+}
@@ -0,0 +1,153 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/fields/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type ViewOptions struct {
+	cmdutils.BaseOptions
+
+	Field string
+}
+
+func NewCmdView(f factory.Factory, runF func(*ViewOptions) error) *cobra.Command {
+	opts := &ViewOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view <field>",
+		Short: "View a custom field",
+		Long: heredoc.Doc(`
+				Show the details of a custom field, including its options and the projects
+				it is attached to. The field is given by name or ID.
+			`),
+		Example: heredoc.Doc(`
+				$ asana fields view Priority
+			`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Field = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runView(opts)
+		},
+	}
+
+	return cmd
+}
+
+func runView(opts *ViewOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	field, err := shared.ResolveField(client, cfg, opts.Field)
+	if err != nil {
+		return err
+	}
+	if err := field.Fetch(client, &asana.Options{Fields: shared.FieldFields}); err != nil {
+		return fmt.Errorf("failed to fetch custom field: %w", err)
+	}
+
+	projects, err := projectsUsing(client, cfg.Workspace.ID, field)
+	if err != nil {
+		return err
+	}
+
+	opts.IO.Printf("%s %s\n", cs.Bold(field.Name), cs.Gray(field.ID))
+	opts.IO.Printf("%s %s\n", cs.Gray("Type:"), field.ResourceSubtype)
+	if field.Description != "" {
+		opts.IO.Printf("%s %s\n", cs.Gray("Description:"), field.Description)
+	}
+	if field.ResourceSubtype == asana.FieldTypeNumber {
+		if field.Format != "" {
+			opts.IO.Printf("%s %s\n", cs.Gray("Format:"), describeFormat(field))
+		}
+		if field.Precision != nil {
+			opts.IO.Printf("%s %d\n", cs.Gray("Precision:"), *field.Precision)
+		}
+	}
+
+	if len(field.EnumOptions) > 0 {
+		opts.IO.Printf("\n%s\n", cs.Bold("Options"))
+		for _, o := range field.EnumOptions {
+			line := "  • " + o.Name
+			if o.Color != "" && o.Color != "none" {
+				line += cs.Gray(" (" + o.Color + ")")
+			}
+			if !o.Enabled {
+				line += cs.Gray(" disabled")
+			}
+			opts.IO.Println(line)
+		}
+	}
+
+	opts.IO.Printf("\n%s\n", cs.Bold("Projects"))
+	if len(projects) == 0 {
+		opts.IO.Println("  Not attached to any project")
+	}
+	for _, p := range projects {
+		opts.IO.Printf("  • %s %s\n", p.Name, cs.Gray(p.ID))
+	}
+
+	return nil
+}
+
+func describeFormat(f *asana.CustomField) string {
+	switch f.Format {
+	case asana.Currency:
+		return fmt.Sprintf("%s (%s)", f.Format, f.CurrencyCode)
+	case asana.Custom:
+		return fmt.Sprintf("%s (%q as %s)", f.Format, f.CustomLabel, f.CustomLabelPosition)
+	default:
+		return string(f.Format)
+	}
+}
+
+// projectsUsing returns the projects of the workspace that have field
+// attached. The API has no reverse lookup, so all projects are scanned.
+func projectsUsing(client *asana.Client, workspaceID string, field *asana.CustomField) ([]*asana.Project, error) {
+	ws := &asana.Workspace{ID: workspaceID}
+	projects, err := ws.AllProjects(client, &asana.Options{
+		Fields: []string{"name", "custom_field_settings.custom_field"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch projects: %w", err)
+	}
+
+	var using []*asana.Project
+	for _, p := range projects {
+		for _, s := range p.CustomFieldSettings {
+			if s.CustomField != nil && s.CustomField.ID == field.ID {
+				using = append(using, p)
+				break
+			}
+		}
+	}
+
+	return using, nil
+}
@@ -0,0 +1,133 @@
+package branch
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/internal/git"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type BranchOptions struct {
+	cmdutils.BaseOptions
+
+	Task       string
+	Template   string
+	NoCheckout bool
+}
+
+func NewCmdBranch(f factory.Factory, runF func(*BranchOptions) error) *cobra.Command {
+	opts := &BranchOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:        f.IOStreams,
+			Prompter:  f.Prompter,
+			Config:    f.Config,
+			Client:    f.Client,
+			GitClient: f.GitClient,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "branch [<task>]",
+		Short: "Create a git branch for a task",
+		Long: heredoc.Docf(`
+				Create and check out a git branch named after a task.
+
+				The name is rendered from a Go template with the fields %[1]s.GID%[1]s, %[1]s.Name%[1]s
+				and %[1]s.Slug%[1]s. The template is taken from --template, the %[1]sbranch_template%[1]s
+				key of a linked %[1]s.asana.yml%[1]s, or defaults to %[1]s%[2]s%[1]s.
+			`, "`", git.DefaultBranchTemplate),
+		Example: heredoc.Doc(`
+				# Select one of your tasks and create a branch for it
+				$ asana git branch
+
+				# Create a branch for a task with a custom name
+				$ asana git branch 1204567890123 --template "feature/{{.GID}}-{{.Slug}}"
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Task = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runBranch(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Template, "template", "t", "", "Template for the branch name")
+	cmd.Flags().BoolVar(&opts.NoCheckout, "no-checkout", false, "Create the branch without checking it out")
+
+	return cmd
+}
+
+func runBranch(opts *BranchOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	if !opts.GitClient.IsRepository() {
+		return errors.New("not a git repository")
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	// Without an argument always prompt, since the current branch may
+	// already belong to another task.
+	var task *asana.Task
+	if opts.Task != "" {
+		task, err = cmdutils.ResolveTask(&opts.BaseOptions, client, opts.Task, "")
+	} else {
+		task, err = cmdutils.SelectTask(&opts.BaseOptions, client, "Select a task to create a branch for:")
+	}
+	if err != nil {
+		return err
+	}
+	if task == nil {
+		return cmdutils.ErrNoTask
+	}
+
+	tmpl := opts.Template
+	if tmpl == "" && cfg.Link != nil {
+		tmpl = cfg.Link.BranchTemplate
+	}
+
+	name, err := git.BranchName(tmpl, task.ID, task.Name)
+	if err != nil {
+		return err
+	}
+	if err := opts.GitClient.ValidateBranchName(name); err != nil {
+		return err
+	}
+
+	if opts.GitClient.BranchExists(name) {
+		if opts.NoCheckout {
+			return fmt.Errorf("branch %q already exists", name)
+		}
+		if err := opts.GitClient.Checkout(name); err != nil {
+			return err
+		}
+		opts.IO.Printf("%s Switched to existing branch %s\n", cs.WarningIcon, cs.Bold(name))
+		return nil
+	}
+
+	if err := opts.GitClient.CreateBranch(name, !opts.NoCheckout); err != nil {
+		return err
+	}
+
+	opts.IO.Printf("%s Created branch %s for %s\n", cs.SuccessIcon, cs.Bold(name), task.Name)
+	return nil
+}
@@ -0,0 +1,153 @@
+package commitmsg
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+// TrailerKey is the git trailer that references the Asana task of a commit.
+const TrailerKey = "Asana-Task"
+
+const hookMarker = "# asana-cli commit-msg hook"
+
+// The hook never blocks a commit, even if the CLI is not authenticated.
+var hookScript = heredoc.Docf(`
+	#!/bin/sh
+	%s
+	asana git commit-msg "$1" || true
+`, hookMarker)
+
+type CommitMsgOptions struct {
+	cmdutils.BaseOptions
+
+	File    string
+	Install bool
+	Force   bool
+}
+
+func NewCmdCommitMsg(f factory.Factory, runF func(*CommitMsgOptions) error) *cobra.Command {
+	opts := &CommitMsgOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:        f.IOStreams,
+			Config:    f.Config,
+			Client:    f.Client,
+			GitClient: f.GitClient,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "commit-msg [<file>]",
+		Short: "Add an Asana task trailer to a commit message",
+		Long: heredoc.Docf(`
+				Append an %[1]s%[2]s: <permalink>%[1]s trailer to the commit message in <file> when
+				the current branch name encodes a task ID. Commits on other branches are left
+				untouched.
+
+				Use --install to register this command as the repository's commit-msg hook.
+			`, "`", TrailerKey),
+		Example: heredoc.Doc(`
+				# Install the commit-msg hook in the current repository
+				$ asana git commit-msg --install
+			`),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if opts.Install {
+				return cobra.NoArgs(cmd, args)
+			}
+			return cobra.ExactArgs(1)(cmd, args)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.File = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			if opts.Install {
+				return runInstall(opts)
+			}
+			return runCommitMsg(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Install, "install", false, "Install the commit-msg hook")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Replace an existing commit-msg hook")
+
+	return cmd
+}
+
+func runCommitMsg(opts *CommitMsgOptions) error {
+	id := cmdutils.TaskIDFromBranch(opts.GitClient)
+	if id == "" {
+		return nil
+	}
+
+	url := permalink(opts, id)
+
+	return opts.GitClient.AddTrailer(opts.File, TrailerKey, url)
+}
+
+// permalink looks up the task's permalink. Hooks must not fail because of
+// network or authentication problems, so a canonical URL is used instead.
+func permalink(opts *CommitMsgOptions, id string) string {
+	fallback := fmt.Sprintf("https://app.asana.com/0/0/%s", id)
+
+	client, err := opts.Client()
+	if err != nil {
+		return fallback
+	}
+
+	task := &asana.Task{ID: id}
+	if err := task.Fetch(client, &asana.Options{Fields: []string{"permalink_url"}}); err != nil {
+		opts.IO.ErrPrintf("warning: could not fetch task %s: %s\n", id, err)
+		return fallback
+	}
+
+	if task.PermalinkURL == "" {
+		return fallback
+	}
+	return task.PermalinkURL
+}
+
+func runInstall(opts *CommitMsgOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	dir, err := opts.GitClient.HooksDir()
+	if err != nil {
+		return errors.New("not a git repository")
+	}
+	path := filepath.Join(dir, "commit-msg")
+
+	existing, err := os.ReadFile(path)
+	switch {
+	case err == nil && strings.Contains(string(existing), hookMarker):
+		opts.IO.Printf("%s The commit-msg hook is already installed\n", cs.SuccessIcon)
+		return nil
+	case err == nil && !opts.Force:
+		return fmt.Errorf("a commit-msg hook already exists at %s; use --force to replace it", path)
+	case err != nil && !errors.Is(err, os.ErrNotExist):
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return fmt.Errorf("failed to create hooks directory: %w", err)
+	}
+
+	//nolint:gosec // hooks must be executable
+	if err := os.WriteFile(path, []byte(hookScript), 0755); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	opts.IO.Printf("%s Installed commit-msg hook at %s\n", cs.SuccessIcon, path)
+	return nil
+}
@@ -0,0 +1,30 @@
+package git
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/pkg/cmd/git/branch"
+	"github.com/timwehrle/asana/pkg/cmd/git/commitmsg"
+	"github.com/timwehrle/asana/pkg/cmd/git/sync"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+func NewCmdGit(f factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "git <subcommand>",
+		Short: "Connect git branches and commits to Asana tasks",
+		Long: heredoc.Doc(`
+				Create branches named after tasks, reference tasks in commit messages and
+				post commit summaries to tasks.
+
+				Commands that expect a task use the task ID encoded in the current branch
+				name when no task is given.
+			`),
+	}
+
+	cmd.AddCommand(branch.NewCmdBranch(f, nil))
+	cmd.AddCommand(commitmsg.NewCmdCommitMsg(f, nil))
+	cmd.AddCommand(sync.NewCmdSync(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,151 @@
+package sync
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/internal/git"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type SyncOptions struct {
+	cmdutils.BaseOptions
+
+	Task   string
+	Base   string
+	DryRun bool
+}
+
+func NewCmdSync(f factory.Factory, runF func(*SyncOptions) error) *cobra.Command {
+	opts := &SyncOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:        f.IOStreams,
+			Prompter:  f.Prompter,
+			Config:    f.Config,
+			Client:    f.Client,
+			GitClient: f.GitClient,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "sync [<task>]",
+		Short: "Post the commits of the current branch to a task",
+		Long: heredoc.Doc(`
+				Post the commits of the current branch as a comment on a task.
+
+				The first sync lists all commits since the base branch. Later syncs only
+				list commits added since the previous one, so running the command again
+				does not repeat earlier commits.
+			`),
+		Example: heredoc.Doc(`
+				# Post new commits to the task encoded in the branch name
+				$ asana git sync
+
+				# Preview the comment without posting it
+				$ asana git sync --dry-run
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Task = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runSync(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Base, "base", "", "Branch the commits are compared against")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Print the comment instead of posting it")
+
+	return cmd
+}
+
+func syncedKey(branch string) string {
+	return fmt.Sprintf("branch.%s.asana-synced", branch)
+}
+
+func runSync(opts *SyncOptions) error {
+	cs := opts.IO.ColorScheme()
+	gc := opts.GitClient
+
+	if !gc.IsRepository() {
+		return errors.New("not a git repository")
+	}
+
+	branch, err := gc.CurrentBranch()
+	if err != nil {
+		return err
+	}
+
+	head, err := gc.RevParse("HEAD")
+	if err != nil {
+		return errors.New("the current branch has no commits")
+	}
+
+	from := gc.Config(syncedKey(branch))
+	if from == "" || opts.Base != "" {
+		from = opts.Base
+		if from == "" {
+			if from, err = gc.DefaultBranch(); err != nil {
+				return err
+			}
+		}
+	}
+
+	commits, err := gc.Commits(from + "..HEAD")
+	if err != nil {
+		return err
+	}
+	if len(commits) == 0 {
+		opts.IO.Println("No new commits to sync.")
+		return nil
+	}
+
+	text := formatComment(branch, commits)
+
+	if opts.DryRun {
+		opts.IO.Println(text)
+		return nil
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	task, err := cmdutils.ResolveTask(&opts.BaseOptions, client, opts.Task, "Select a task to sync commits to:")
+	if err != nil {
+		return err
+	}
+
+	if _, err := task.CreateComment(client, &asana.StoryBase{Text: text}); err != nil {
+		return fmt.Errorf("failed to post comment: %w", err)
+	}
+
+	if err := gc.SetConfig(syncedKey(branch), head); err != nil {
+		return fmt.Errorf("failed to record sync state: %w", err)
+	}
+
+	opts.IO.Printf("%s Posted %d commit(s) to %s\n", cs.SuccessIcon, len(commits), task.Name)
+	return nil
+}
+
+func formatComment(branch string, commits []git.Commit) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Commits on %s:\n", branch)
+	for _, c := range commits {
+		fmt.Fprintf(&b, "\n• %s %s", c.ShortSha(), c.Subject)
+	}
+
+	return b.String()
+}
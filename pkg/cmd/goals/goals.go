@@ -0,0 +1,24 @@
+package goals
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/pkg/cmd/goals/list"
+	"github.com/timwehrle/asana/pkg/cmd/goals/updatemetric"
+	"github.com/timwehrle/asana/pkg/cmd/goals/view"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+func NewCmdGoals(f factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "goals <subcommand>",
+		Aliases: []string{"goal"},
+		Short:   "Manage goals",
+		Long:    "Perform operations related to the goals of your workspace.",
+	}
+
+	cmd.AddCommand(list.NewCmdList(f, nil))
+	cmd.AddCommand(view.NewCmdView(f, nil))
+	cmd.AddCommand(updatemetric.NewCmdUpdateMetric(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,127 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/goals/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type ListOptions struct {
+	cmdutils.BaseOptions
+
+	Team       string
+	TimePeriod string
+	JSON       bool
+}
+
+func NewCmdList(f factory.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List goals",
+		Long: heredoc.Doc(`
+				List the goals of your workspace with their status, owner and metric.
+
+				Narrow the list to the goals of a team or a time period such as "Q1 FY25".
+			`),
+		Example: heredoc.Doc(`
+				$ asana goals list
+				$ asana goals list --team Engineering --time-period "Q1 FY25"
+				$ asana goals list --json
+			`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runList(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Team, "team", "t", "", "Only list goals of this team")
+	cmd.Flags().StringVarP(&opts.TimePeriod, "time-period", "p", "", "Only list goals of this time period")
+	cmd.Flags().BoolVar(&opts.JSON, "json", false, "Output goals as JSON")
+
+	return cmd
+}
+
+func runList(opts *ListOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	query := &asana.GoalQuery{Workspace: cfg.Workspace.ID}
+	if opts.Team != "" {
+		team, err := cmdutils.ResolveTeam(client, cfg, opts.Prompter, opts.Team)
+		if err != nil {
+			return err
+		}
+		// The API accepts either a workspace or a team
+		query = &asana.GoalQuery{Team: team.ID}
+	}
+	if opts.TimePeriod != "" {
+		period, err := shared.ResolveTimePeriod(client, cfg, opts.TimePeriod)
+		if err != nil {
+			return err
+		}
+		query.TimePeriods = []string{period.ID}
+	}
+
+	goals, err := client.AllGoals(query, &asana.Options{Fields: shared.GoalFields})
+	if err != nil {
+		return fmt.Errorf("failed to fetch goals: %w", err)
+	}
+
+	if opts.JSON {
+		enc := json.NewEncoder(opts.IO.Out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(goals)
+	}
+
+	opts.IO.Printf("\nGoals in %s:\n\n", cs.Bold(cfg.Workspace.Name))
+	if len(goals) == 0 {
+		opts.IO.Println("No goals found")
+		return nil
+	}
+
+	for i, g := range goals {
+		line := fmt.Sprintf("%d. %s %s", i+1, cs.Bold(g.Name), shared.Badge(opts.IO, g.Status))
+		if g.Metric != nil && g.Metric.TargetNumberValue != nil {
+			line += fmt.Sprintf(" %s / %s",
+				shared.FormatValue(g.Metric, g.Metric.CurrentNumberValue),
+				shared.FormatValue(g.Metric, g.Metric.TargetNumberValue))
+		}
+		if g.Owner != nil {
+			line += cs.Gray(" @" + g.Owner.Name)
+		}
+		if g.TimePeriod != nil {
+			line += cs.Gray(" · " + g.TimePeriod.DisplayName)
+		}
+		opts.IO.Println(line + " " + cs.Gray(g.ID))
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package shared
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/internal/config"
+	"github.com/timwehrle/asana/internal/prompter"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/format"
+	"github.com/timwehrle/asana/pkg/iostreams"
+)
+
+// GoalFields are the goal fields shown by the goal commands.
+var GoalFields = []string{
+	"name", "notes", "status", "due_on", "start_on", "is_workspace_level",
+	"owner.name", "team.name", "time_period.display_name",
+	"metric.unit", "metric.precision", "metric.currency_code", "metric.progress_source",
+	"metric.initial_number_value", "metric.target_number_value", "metric.current_number_value",
+}
+
+var gidRe = regexp.MustCompile(`^[0-9]+$`)
+
+var statusNames = map[asana.GoalStatus]string{
+	asana.GoalOnTrack:  "On track",
+	asana.GoalAtRisk:   "At risk",
+	asana.GoalOffTrack: "Off track",
+	asana.GoalAchieved: "Achieved",
+	asana.GoalPartial:  "Partial",
+	asana.GoalMissed:   "Missed",
+	asana.GoalDropped:  "Dropped",
+}
+
+// ResolveGoal returns the goal of the workspace identified by nameOrID, or
+// lets the user pick one when nameOrID is empty.
+func ResolveGoal(
+	client *asana.Client,
+	cfg *config.Config,
+	p prompter.Prompter,
+	nameOrID string,
+) (*asana.Goal, error) {
+	if gidRe.MatchString(nameOrID) {
+		goal := &asana.Goal{ID: nameOrID}
+		if err := goal.Fetch(client, &asana.Options{Fields: []string{"name"}}); err == nil {
+			return goal, nil
+		} else if !asana.IsNotFoundError(err) {
+			return nil, fmt.Errorf("failed to fetch goal: %w", err)
+		}
+	}
+
+	goals, err := client.AllGoals(
+		&asana.GoalQuery{Workspace: cfg.Workspace.ID},
+		&asana.Options{Fields: []string{"name"}},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch goals: %w", err)
+	}
+
+	if nameOrID != "" {
+		for _, goal := range goals {
+			if strings.EqualFold(goal.Name, nameOrID) {
+				return goal, nil
+			}
+		}
+		return nil, fmt.Errorf("goal %q not found", nameOrID)
+	}
+
+	if len(goals) == 0 {
+		return nil, fmt.Errorf("no goals found")
+	}
+
+	names := format.MapToStrings(goals, func(g *asana.Goal) string {
+		return g.Name
+	})
+
+	selected, err := p.Select("Select goal: ", names)
+	if err != nil {
+		return nil, fmt.Errorf("goal selection failed: %w", err)
+	}
+	return goals[selected], nil
+}
+
+// ResolveTimePeriod returns the time period of the workspace with the given
+// display name, such as "Q1 FY25", or ID.
+func ResolveTimePeriod(client *asana.Client, cfg *config.Config, nameOrID string) (*asana.TimePeriod, error) {
+	ws := &asana.Workspace{ID: cfg.Workspace.ID}
+	periods, err := ws.AllTimePeriods(client, &asana.Options{Fields: []string{"display_name"}})
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch time periods: %w", err)
+	}
+
+	for _, period := range periods {
+		if period.ID == nameOrID || strings.EqualFold(period.DisplayName, nameOrID) {
+			return period, nil
+		}
+	}
+
+	names := format.MapToStrings(periods, func(p *asana.TimePeriod) string {
+		return p.DisplayName
+	})
+	if len(names) == 0 {
+		return nil, fmt.Errorf("time period %q not found: the workspace has no time periods", nameOrID)
+	}
+	return nil, fmt.Errorf("time period %q not found, available periods: %s", nameOrID, strings.Join(names, ", "))
+}
+
+// StatusName returns the display name of a goal status.
+func StatusName(s asana.GoalStatus) string {
+	if name, ok := statusNames[s]; ok {
+		return name
+	}
+	if s == "" {
+		return "No status"
+	}
+	return string(s)
+}
+
+// Badge returns the colored display name of a goal status.
+func Badge(ios *iostreams.IOStreams, s asana.GoalStatus) string {
+	cs := ios.ColorScheme()
+	name := StatusName(s)
+
+	switch s {
+	case asana.GoalOnTrack, asana.GoalAchieved:
+		return ios.ColorFromScheme(name, cs.Success)
+	case asana.GoalAtRisk, asana.GoalPartial:
+		return ios.ColorFromScheme(name, cs.Warning)
+	case asana.GoalOffTrack, asana.GoalMissed:
+		return ios.ColorFromScheme(name, cs.Error)
+	case "":
+		return ios.ColorFromScheme(name, cs.Gray)
+	default:
+		return name
+	}
+}
+
+// FormatValue formats a metric value in the unit of the metric. Percentages
+// are stored as fractions and shown multiplied by 100.
+func FormatValue(m *asana.GoalMetric, v *float64) string {
+	if v == nil {
+		return "-"
+	}
+
+	n := *v
+	if m.Unit == asana.MetricUnitPercentage {
+		n *= 100
+	}
+
+	precision := -1
+	if m.Precision != nil {
+		precision = *m.Precision
+	}
+	s := strconv.FormatFloat(n, 'f', precision, 64)
+
+	switch m.Unit {
+	case asana.MetricUnitPercentage:
+		return s + "%"
+	case asana.MetricUnitCurrency:
+		if m.CurrencyCode != "" {
+			return s + " " + m.CurrencyCode
+		}
+	}
+	return s
+}
+
+// ParseValue parses a metric value as cmdutils.ParseNumber does, so the
+// values of percentage metrics are given in percent.
+func ParseValue(m *asana.GoalMetric, value string) (float64, error) {
+	return cmdutils.ParseNumber(value, m.Unit == asana.MetricUnitPercentage, m.Precision)
+}
+
+// Progress returns how far the current value of the metric has moved from
+// its initial towards its target value, in percent from 0 to 100.
+func Progress(m *asana.GoalMetric) int {
+	if m == nil || m.CurrentNumberValue == nil || m.TargetNumberValue == nil {
+		return 0
+	}
+
+	initial := 0.0
+	if m.InitialNumberValue != nil {
+		initial = *m.InitialNumberValue
+	}
+	span := *m.TargetNumberValue - initial
+	if span == 0 {
+		if *m.CurrentNumberValue == *m.TargetNumberValue {
+			return 100
+		}
+		return 0
+	}
+
+	p := int((*m.CurrentNumberValue - initial) / span * 100)
+	return max(0, min(100, p))
+}
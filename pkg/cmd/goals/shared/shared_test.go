@@ -0,0 +1,91 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/internal/api/asana"
+)
+
+func intPtr(n int) *int { return &n }
+
+func float(f float64) *float64 { return &f }
+
+func TestParseValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		metric  *asana.GoalMetric
+		value   string
+		want    float64
+		wantErr string
+	}{
+		{
+			name:   "plain number",
+			metric: &asana.GoalMetric{Unit: asana.MetricUnitNone},
+			value:  "42",
+			want:   42,
+		},
+		{
+			name:   "percent sign",
+			metric: &asana.GoalMetric{Unit: asana.MetricUnitPercentage},
+			value:  "75%",
+			want:   0.75,
+		},
+		{
+			name:   "percentage without sign",
+			metric: &asana.GoalMetric{Unit: asana.MetricUnitPercentage},
+			value:  "75",
+			want:   0.75,
+		},
+		{
+			name:    "percent sign on plain metric",
+			metric:  &asana.GoalMetric{Unit: asana.MetricUnitNone},
+			value:   "75%",
+			wantErr: `expected a number, got "75%"`,
+		},
+		{
+			name:    "too many decimals",
+			metric:  &asana.GoalMetric{Unit: asana.MetricUnitCurrency, Precision: intPtr(2)},
+			value:   "9.999",
+			wantErr: "9.999 allows at most 2 decimal place(s)",
+		},
+		{
+			name:    "not a number",
+			metric:  &asana.GoalMetric{},
+			value:   "lots",
+			wantErr: `expected a number, got "lots"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseValue(tt.metric, tt.value)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.InDelta(t, tt.want, got, 1e-9)
+		})
+	}
+}
+
+func TestFormatValue(t *testing.T) {
+	assert.Equal(t, "-", FormatValue(&asana.GoalMetric{}, nil))
+	assert.Equal(t, "75%", FormatValue(&asana.GoalMetric{Unit: asana.MetricUnitPercentage, Precision: intPtr(0)}, float(0.75)))
+	assert.Equal(t, "1200.50 EUR", FormatValue(
+		&asana.GoalMetric{Unit: asana.MetricUnitCurrency, CurrencyCode: "EUR", Precision: intPtr(2)}, float(1200.5)))
+	assert.Equal(t, "3.5", FormatValue(&asana.GoalMetric{Unit: asana.MetricUnitNone}, float(3.5)))
+}
+
+func TestProgress(t *testing.T) {
+	assert.Equal(t, 0, Progress(nil))
+	assert.Equal(t, 50, Progress(&asana.GoalMetric{
+		InitialNumberValue: float(10), TargetNumberValue: float(30), CurrentNumberValue: float(20),
+	}))
+	assert.Equal(t, 100, Progress(&asana.GoalMetric{TargetNumberValue: float(5), CurrentNumberValue: float(8)}))
+	assert.Equal(t, 25, Progress(&asana.GoalMetric{
+		InitialNumberValue: float(100), TargetNumberValue: float(0), CurrentNumberValue: float(75),
+	}))
+}
@@ -0,0 +1,103 @@
+package updatemetric
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/goals/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type UpdateMetricOptions struct {
+	cmdutils.BaseOptions
+
+	Goal  string
+	Value string
+}
+
+func NewCmdUpdateMetric(f factory.Factory, runF func(*UpdateMetricOptions) error) *cobra.Command {
+	opts := &UpdateMetricOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "update-metric <goal> <value>",
+		Short: "Set the current value of a goal's metric",
+		Long: heredoc.Doc(`
+				Set the current value of the metric measuring a goal, for example from a
+				build pipeline.
+
+				The value is a number in the unit of the metric. Percentages are given in
+				percent, with or without a percent sign, so 75 and 75% both mean 75%.
+			`),
+		Example: heredoc.Doc(`
+				$ asana goals update-metric "Test coverage" 82.5
+				$ asana goals update-metric 1204567890123 1200
+			`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Goal = args[0]
+			opts.Value = args[1]
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runUpdateMetric(opts)
+		},
+	}
+
+	return cmd
+}
+
+func runUpdateMetric(opts *UpdateMetricOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	goal, err := shared.ResolveGoal(client, cfg, opts.Prompter, opts.Goal)
+	if err != nil {
+		return err
+	}
+	if err := goal.Fetch(client, &asana.Options{Fields: shared.GoalFields}); err != nil {
+		return fmt.Errorf("failed to fetch goal: %w", err)
+	}
+
+	if goal.Metric == nil {
+		return fmt.Errorf("goal %q has no metric", goal.Name)
+	}
+
+	value, err := shared.ParseValue(goal.Metric, opts.Value)
+	if err != nil {
+		return fmt.Errorf("invalid metric value: %w", err)
+	}
+
+	metric := goal.Metric
+	if err := goal.SetMetricCurrentValue(client, value); err != nil {
+		return fmt.Errorf("failed to update metric: %w", err)
+	}
+
+	line := shared.FormatValue(metric, &value)
+	if metric.TargetNumberValue != nil {
+		line += " of " + shared.FormatValue(metric, metric.TargetNumberValue)
+	}
+	opts.IO.Printf("%s Set metric of %s to %s\n", cs.SuccessIcon, cs.Bold(goal.Name), line)
+
+	return nil
+}
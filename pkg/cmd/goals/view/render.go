@@ -0,0 +1,119 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/goals/shared"
+	statusshared "github.com/timwehrle/asana/pkg/cmd/projects/status/shared"
+	"github.com/timwehrle/asana/pkg/format"
+	"github.com/timwehrle/asana/pkg/iostreams"
+)
+
+// barWidth is the number of cells of a progress bar.
+const barWidth = 10
+
+// row is a line of the tree before it is padded into columns.
+type row struct {
+	label string
+	node  *node
+}
+
+// writeGoal prints the goal details followed by the tree of resources
+// supporting it.
+func writeGoal(ios *iostreams.IOStreams, g *asana.Goal, parents []*asana.Goal, tree *node) {
+	cs := ios.ColorScheme()
+	out := ios.Out
+
+	fmt.Fprintf(out, "%s %s\n", cs.Bold(g.Name), cs.Gray(g.ID))
+
+	var meta []string
+	if g.Owner != nil {
+		meta = append(meta, "Owner: "+g.Owner.Name)
+	}
+	if g.Team != nil {
+		meta = append(meta, "Team: "+g.Team.Name)
+	}
+	if g.TimePeriod != nil {
+		meta = append(meta, g.TimePeriod.DisplayName)
+	}
+	if g.DueOn != nil {
+		meta = append(meta, "Due "+format.Date(g.DueOn))
+	}
+	if len(meta) > 0 {
+		fmt.Fprintln(out, cs.Gray(strings.Join(meta, " · ")))
+	}
+
+	fmt.Fprintf(out, "Status: %s\n", shared.Badge(ios, g.Status))
+	if m := g.Metric; m != nil {
+		line := "Metric: " + shared.FormatValue(m, m.CurrentNumberValue)
+		if m.TargetNumberValue != nil {
+			line += " of " + shared.FormatValue(m, m.TargetNumberValue)
+			line += fmt.Sprintf(" %s %d%%", format.ProgressBar(*tree.Progress, 100, barWidth), *tree.Progress)
+		}
+		fmt.Fprintln(out, line)
+	}
+	if len(parents) > 0 {
+		names := format.MapToStrings(parents, func(p *asana.Goal) string {
+			return p.Name
+		})
+		fmt.Fprintf(out, "Supports: %s\n", strings.Join(names, ", "))
+	}
+	if g.Notes != "" {
+		fmt.Fprintf(out, "\n%s\n", format.Indent(strings.TrimSpace(g.Notes), "  "))
+	}
+	fmt.Fprintln(out)
+
+	if len(tree.Items) == 0 {
+		fmt.Fprintln(out, "Nothing supports this goal yet")
+		return
+	}
+	fmt.Fprintln(out, "Supported by:")
+
+	var rows []row
+	var collect func(nodes []*node, prefix string)
+	collect = func(nodes []*node, prefix string) {
+		for i, n := range nodes {
+			branch, indent := "├── ", "│   "
+			if i == len(nodes)-1 {
+				branch, indent = "└── ", "    "
+			}
+			rows = append(rows, row{label: prefix + branch + n.Name, node: n})
+			collect(n.Items, prefix+indent)
+		}
+	}
+	collect(tree.Items, "")
+
+	labelWidth := 0
+	for _, r := range rows {
+		labelWidth = max(labelWidth, utf8.RuneCountInString(r.label))
+	}
+
+	for _, r := range rows {
+		n := r.node
+		label := r.label + strings.Repeat(" ", labelWidth-utf8.RuneCountInString(r.label))
+		if n.Type == typeGoal {
+			label = strings.Replace(label, n.Name, cs.Bold(n.Name), 1)
+		}
+
+		var line string
+		switch {
+		case n.Cycle:
+			line = label + "  " + cs.Error("(cycle)")
+		case n.Type == typeGoal:
+			line = label + "  " + shared.Badge(ios, asana.GoalStatus(n.Status))
+			if n.Progress != nil {
+				line += fmt.Sprintf("  %s %3d%%", format.ProgressBar(*n.Progress, 100, barWidth), *n.Progress)
+			}
+		case n.Type == typeProject && n.Status != "":
+			line = label + "  " + cs.Gray(n.Type) + "  " + statusshared.Badge(ios, asana.StatusType(n.Status))
+		default:
+			line = label + "  " + cs.Gray(n.Type)
+		}
+		fmt.Fprintln(out, line)
+	}
+}
+
+// bar draws a progress bar of the given percentage.
@@ -0,0 +1,240 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/goals/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type ViewOptions struct {
+	cmdutils.BaseOptions
+
+	Goal string
+	JSON bool
+}
+
+// Resource types of the resources supporting a goal.
+const (
+	typeGoal      = "goal"
+	typeProject   = "project"
+	typePortfolio = "portfolio"
+	typeTask      = "task"
+)
+
+// node is a goal or a resource supporting it in the goal tree.
+type node struct {
+	ID     string `json:"gid"`
+	Type   string `json:"type"`
+	Name   string `json:"name"`
+	Status string `json:"status,omitempty"`
+
+	// Progress is the progress of a goal's metric towards its target, in
+	// percent. It is nil for goals without a target and other resources.
+	Progress *int `json:"progress,omitempty"`
+
+	Weight *float64 `json:"contribution_weight,omitempty"`
+	Items  []*node  `json:"items,omitempty"`
+
+	// Cycle is set if the goal already supports itself further up the tree.
+	// Its supporting resources are not expanded again.
+	Cycle bool `json:"cycle,omitempty"`
+}
+
+// goalView is a goal with the goals it supports and the tree of resources
+// supporting it.
+type goalView struct {
+	Goal    *asana.Goal   `json:"goal"`
+	Parents []*asana.Goal `json:"parent_goals,omitempty"`
+	Tree    *node         `json:"supporting"`
+}
+
+// source loads the resources supporting a goal.
+type source struct {
+	relationships func(goalID string) ([]*asana.GoalRelationship, error)
+	goal          func(id string) (*asana.Goal, error)
+	project       func(id string) (*asana.Project, error)
+}
+
+func NewCmdView(f factory.Factory, runF func(*ViewOptions) error) *cobra.Command {
+	opts := &ViewOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view [<goal>]",
+		Short: "View a goal and what supports it",
+		Long: heredoc.Doc(`
+				Show the status, owner, time period and metric of a goal, the goals it
+				supports, and the tree of sub-goals, projects, portfolios and tasks
+				supporting it. Sub-goals are expanded with their own supporting work.
+			`),
+		Example: heredoc.Doc(`
+				$ asana goals view "Grow revenue"
+				$ asana goals view 1204567890123 --json
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Goal = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runView(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.JSON, "json", false, "Output the goal and its tree as JSON")
+
+	return cmd
+}
+
+func runView(opts *ViewOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	goal, err := shared.ResolveGoal(client, cfg, opts.Prompter, opts.Goal)
+	if err != nil {
+		return err
+	}
+	if err := goal.Fetch(client, &asana.Options{Fields: shared.GoalFields}); err != nil {
+		return fmt.Errorf("failed to fetch goal: %w", err)
+	}
+
+	parents, err := goal.ParentGoals(client, &asana.Options{Fields: []string{"name", "status"}})
+	if err != nil {
+		return fmt.Errorf("failed to fetch parent goals: %w", err)
+	}
+
+	tree, err := build(goal, apiSource(client))
+	if err != nil {
+		return err
+	}
+
+	if opts.JSON {
+		enc := json.NewEncoder(opts.IO.Out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(&goalView{Goal: goal, Parents: parents, Tree: tree})
+	}
+
+	writeGoal(opts.IO, goal, parents, tree)
+	return nil
+}
+
+func apiSource(client *asana.Client) source {
+	return source{
+		relationships: func(id string) ([]*asana.GoalRelationship, error) {
+			g := &asana.Goal{ID: id}
+			relationships, err := g.AllRelationships(client, &asana.Options{Fields: []string{
+				"resource_subtype", "contribution_weight",
+				"supporting_resource.name", "supporting_resource.resource_type",
+			}})
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch supporting resources: %w", err)
+			}
+			return relationships, nil
+		},
+		goal: func(id string) (*asana.Goal, error) {
+			g := &asana.Goal{ID: id}
+			if err := g.Fetch(client, &asana.Options{Fields: shared.GoalFields}); err != nil {
+				return nil, fmt.Errorf("failed to fetch goal: %w", err)
+			}
+			return g, nil
+		},
+		project: func(id string) (*asana.Project, error) {
+			p := &asana.Project{ID: id}
+			err := p.Fetch(client, &asana.Options{Fields: []string{"name", "current_status_update.status_type"}})
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch project: %w", err)
+			}
+			return p, nil
+		},
+	}
+}
+
+// build loads the tree of resources supporting goal.
+func build(goal *asana.Goal, src source) (*node, error) {
+	root := goalNode(goal)
+
+	var expand func(n *node, path []string) error
+	expand = func(n *node, path []string) error {
+		relationships, err := src.relationships(n.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, r := range relationships {
+			res := r.SupportingResource
+			if res == nil {
+				continue
+			}
+
+			var child *node
+
+			switch res.ResourceType {
+			case typeGoal:
+				g, err := src.goal(res.ID)
+				if err != nil {
+					return err
+				}
+				child = goalNode(g)
+				if slices.Contains(path, child.ID) {
+					child.Cycle = true
+				} else if err := expand(child, append(path[:len(path):len(path)], child.ID)); err != nil {
+					return err
+				}
+			case typeProject:
+				p, err := src.project(res.ID)
+				if err != nil {
+					return err
+				}
+				child = &node{ID: p.ID, Type: typeProject, Name: p.Name}
+				if p.CurrentStatusUpdate != nil {
+					child.Status = string(p.CurrentStatusUpdate.StatusType)
+				}
+			default:
+				child = &node{ID: res.ID, Type: res.ResourceType, Name: res.Name}
+			}
+
+			child.Weight = r.ContributionWeight
+			n.Items = append(n.Items, child)
+		}
+
+		return nil
+	}
+
+	if err := expand(root, []string{root.ID}); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+func goalNode(g *asana.Goal) *node {
+	n := &node{ID: g.ID, Type: typeGoal, Name: g.Name, Status: string(g.Status)}
+	if g.Metric != nil && g.Metric.TargetNumberValue != nil {
+		progress := shared.Progress(g.Metric)
+		n.Progress = &progress
+	}
+	return n
+}
@@ -0,0 +1,130 @@
+package view
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/iostreams"
+)
+
+func float(f float64) *float64 { return &f }
+
+// fakeSource serves goal trees from a map of goal IDs to their supporting
+// resources.
+func fakeSource(supporting map[string][]*asana.GoalSupportingResource) source {
+	return source{
+		relationships: func(id string) ([]*asana.GoalRelationship, error) {
+			var relationships []*asana.GoalRelationship
+			for _, res := range supporting[id] {
+				relationships = append(relationships, &asana.GoalRelationship{SupportingResource: res})
+			}
+			return relationships, nil
+		},
+		goal: func(id string) (*asana.Goal, error) {
+			g := &asana.Goal{ID: id}
+			g.Name = "Goal " + id
+			g.Status = asana.GoalAtRisk
+			g.Metric = &asana.GoalMetric{TargetNumberValue: float(10), CurrentNumberValue: float(5)}
+			return g, nil
+		},
+		project: func(id string) (*asana.Project, error) {
+			p := &asana.Project{ID: id}
+			p.Name = "Project " + id
+			p.CurrentStatusUpdate = &asana.StatusUpdate{}
+			p.CurrentStatusUpdate.StatusType = asana.StatusOnTrack
+			return p, nil
+		},
+	}
+}
+
+func goal(id string) *asana.GoalSupportingResource {
+	return &asana.GoalSupportingResource{ID: id, ResourceType: typeGoal}
+}
+
+func project(id string) *asana.GoalSupportingResource {
+	return &asana.GoalSupportingResource{ID: id, ResourceType: typeProject}
+}
+
+func task(id, name string) *asana.GoalSupportingResource {
+	return &asana.GoalSupportingResource{ID: id, ResourceType: typeTask, Name: name}
+}
+
+func rootGoal() *asana.Goal {
+	g := &asana.Goal{ID: "root"}
+	g.Name = "Grow revenue"
+	g.Status = asana.GoalOnTrack
+	return g
+}
+
+func TestBuild(t *testing.T) {
+	supporting := map[string][]*asana.GoalSupportingResource{
+		"root":  {goal("sales"), project("p1")},
+		"sales": {task("t1", "Hire reps"), goal("root")},
+	}
+
+	tree, err := build(rootGoal(), fakeSource(supporting))
+	require.NoError(t, err)
+
+	assert.Nil(t, tree.Progress)
+	require.Len(t, tree.Items, 2)
+
+	sales := tree.Items[0]
+	assert.Equal(t, "Goal sales", sales.Name)
+	require.NotNil(t, sales.Progress)
+	assert.Equal(t, 50, *sales.Progress)
+
+	require.Len(t, sales.Items, 2)
+	assert.Equal(t, "Hire reps", sales.Items[0].Name)
+	assert.True(t, sales.Items[1].Cycle)
+	assert.Empty(t, sales.Items[1].Items)
+
+	assert.Equal(t, string(asana.StatusOnTrack), tree.Items[1].Status)
+}
+
+func TestBuildError(t *testing.T) {
+	src := fakeSource(map[string][]*asana.GoalSupportingResource{"root": {project("p1")}})
+	src.project = func(string) (*asana.Project, error) {
+		return nil, errors.New("failed to fetch project: boom")
+	}
+
+	_, err := build(rootGoal(), src)
+	require.EqualError(t, err, "failed to fetch project: boom")
+}
+
+func TestWriteGoal(t *testing.T) {
+	ios, _, out, _ := iostreams.Test()
+
+	supporting := map[string][]*asana.GoalSupportingResource{
+		"root":  {goal("sales"), project("p1")},
+		"sales": {task("t1", "Hire reps")},
+	}
+
+	g := rootGoal()
+	g.Metric = &asana.GoalMetric{Unit: asana.MetricUnitNone, TargetNumberValue: float(100), CurrentNumberValue: float(40)}
+	g.TimePeriod = &asana.TimePeriod{DisplayName: "Q1 FY25"}
+
+	tree, err := build(g, fakeSource(supporting))
+	require.NoError(t, err)
+
+	parent := &asana.Goal{}
+	parent.Name = "Company growth"
+
+	writeGoal(ios, g, []*asana.Goal{parent}, tree)
+
+	assert.Equal(t, "Grow revenue root\n"+
+		"Q1 FY25\n"+
+		"Status: On track\n"+
+		"Metric: 40 of 100 ████░░░░░░ 40%\n"+
+		"Supports: Company growth\n"+
+		"\n"+
+		"Supported by:\n"+
+		"├── Goal sales     At risk  █████░░░░░  50%\n"+
+		"│   └── Hire reps  task\n"+
+		"└── Project p1     project  On track\n",
+		iostreams.StripGray(out.String()))
+}
+
+// stripGray removes the color codes of cs.Gray, which are always emitted.
@@ -0,0 +1,223 @@
+package link
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/internal/config"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+	"github.com/timwehrle/asana/pkg/format"
+)
+
+type LinkOptions struct {
+	cmdutils.BaseOptions
+
+	Project  string
+	Section  string
+	Assignee string
+	Tags     []string
+	Force    bool
+}
+
+func NewCmdLink(f factory.Factory, runF func(*LinkOptions) error) *cobra.Command {
+	opts := &LinkOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "link",
+		Short: "Link the current directory to an Asana project",
+		Long: heredoc.Docf(`
+				Create a %[1]s.asana.yml%[1]s file in the current directory that links it to an
+				Asana project.
+
+				Commands run in this directory or any of its subdirectories use the linked
+				workspace, project, section, assignee and tags as defaults instead of
+				prompting for them.
+			`, "`"),
+		Example: heredoc.Doc(`
+				# Link the current directory interactively
+				$ asana link
+
+				# Link to a project and section with default tags
+				$ asana link --project "Website" --section "Backlog" --tag frontend
+			`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runLink(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Project, "project", "p", "", "Project name or ID to link")
+	cmd.Flags().StringVarP(&opts.Section, "section", "s", "", "Default section name or ID")
+	cmd.Flags().StringVarP(&opts.Assignee, "assignee", "a", "", "Default assignee name, email or 'me'")
+	cmd.Flags().StringSliceVarP(&opts.Tags, "tag", "t", nil, "Default tag names (repeatable)")
+	cmd.Flags().BoolVarP(&opts.Force, "force", "f", false, "Overwrite an existing link without asking")
+
+	return cmd
+}
+
+func runLink(opts *LinkOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to determine working directory: %w", err)
+	}
+	path := filepath.Join(wd, config.LinkFileName)
+
+	if ok, err := confirmOverwrite(opts, path); err != nil || !ok {
+		return err
+	}
+
+	// Resolve against the workspace without any existing link so that the
+	// user is prompted instead of getting the current defaults back.
+	unlinked := &config.Config{
+		Username:  cfg.Username,
+		UserID:    cfg.UserID,
+		Workspace: cfg.Workspace,
+	}
+
+	project, err := cmdutils.ResolveProject(client, unlinked, opts.Prompter, opts.Project)
+	if err != nil {
+		return err
+	}
+
+	link := &config.Link{
+		Path:      path,
+		Workspace: &config.LinkRef{ID: cfg.Workspace.ID, Name: cfg.Workspace.Name},
+		Project:   &config.LinkRef{ID: project.ID, Name: project.Name},
+	}
+
+	// Keep settings that are not managed by this command
+	if existing, err := config.ReadLink(path); err == nil {
+		link.BranchTemplate = existing.BranchTemplate
+	}
+
+	section, err := selectSection(opts, client, unlinked, project)
+	if err != nil {
+		return err
+	}
+	if section != nil {
+		link.Section = &config.LinkRef{ID: section.ID, Name: section.Name}
+	}
+
+	link.Assignee, err = selectAssignee(opts, client, cfg)
+	if err != nil {
+		return err
+	}
+
+	tags, err := cmdutils.ResolveTags(client, cfg, opts.Tags)
+	if err != nil {
+		return err
+	}
+	link.Tags = format.MapToStrings(tags, func(t *asana.Tag) string {
+		return t.Name
+	})
+
+	if err := link.Save(); err != nil {
+		return err
+	}
+
+	opts.IO.Printf("%s Linked %s to project %s\n", cs.SuccessIcon, wd, cs.Bold(project.Name))
+	if link.Section != nil {
+		opts.IO.Printf("  %s %s\n", cs.Gray("Section:"), link.Section.Name)
+	}
+	if link.Assignee != "" {
+		opts.IO.Printf("  %s %s\n", cs.Gray("Assignee:"), link.Assignee)
+	}
+	if len(link.Tags) > 0 {
+		opts.IO.Printf("  %s\n", format.List(cs.Gray("Tags: "), link.Tags))
+	}
+
+	return nil
+}
+
+func confirmOverwrite(opts *LinkOptions, path string) (bool, error) {
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) || opts.Force {
+		return true, nil
+	}
+
+	ok, err := opts.Prompter.Confirm(
+		fmt.Sprintf("%s already exists. Overwrite it?", config.LinkFileName),
+		"No",
+	)
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		opts.IO.Println("Link unchanged.")
+	}
+	return ok, nil
+}
+
+func selectSection(
+	opts *LinkOptions,
+	client *asana.Client,
+	cfg *config.Config,
+	project *asana.Project,
+) (*asana.Section, error) {
+	if opts.Section == "" {
+		if opts.Project != "" {
+			return nil, nil
+		}
+
+		ok, err := opts.Prompter.Confirm("Set a default section?", "No")
+		if err != nil || !ok {
+			return nil, err
+		}
+	}
+
+	return cmdutils.ResolveSection(client, cfg, opts.Prompter, project, opts.Section)
+}
+
+func selectAssignee(opts *LinkOptions, client *asana.Client, cfg *config.Config) (string, error) {
+	assignee := opts.Assignee
+	if assignee == "" && opts.Project == "" {
+		var err error
+		assignee, err = opts.Prompter.Input(
+			"Default assignee (name, email or 'me'), leave blank for none: ",
+			"",
+		)
+		if err != nil {
+			return "", fmt.Errorf("failed to read assignee: %w", err)
+		}
+	}
+
+	assignee = strings.TrimSpace(assignee)
+	if assignee == "" || strings.EqualFold(assignee, "me") {
+		return strings.ToLower(assignee), nil
+	}
+
+	user, err := cmdutils.ResolveUser(client, cfg, assignee)
+	if err != nil {
+		return "", err
+	}
+	return user.ID, nil
+}
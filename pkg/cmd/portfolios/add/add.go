@@ -0,0 +1,91 @@
+package add
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/pkg/cmd/portfolios/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type AddOptions struct {
+	cmdutils.BaseOptions
+
+	Portfolio string
+	Items     []string
+}
+
+func NewCmdAdd(f factory.Factory, runF func(*AddOptions) error) *cobra.Command {
+	opts := &AddOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "add <portfolio> <item>...",
+		Short: "Add projects or portfolios to a portfolio",
+		Long: heredoc.Doc(`
+				Add projects, or other portfolios, to a portfolio. Items are given by name
+				or ID; projects are matched before portfolios.
+			`),
+		Example: heredoc.Doc(`
+				$ asana portfolios add "Roadmap" "Website" "Mobile app"
+				$ asana portfolios add "Company" "Roadmap"
+			`),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Portfolio = args[0]
+			opts.Items = args[1:]
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runAdd(opts)
+		},
+	}
+
+	return cmd
+}
+
+func runAdd(opts *AddOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	portfolio, err := shared.ResolvePortfolio(client, cfg, opts.Prompter, opts.Portfolio)
+	if err != nil {
+		return err
+	}
+
+	items, err := shared.ResolveItems(client, cfg, opts.Items)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		if item.ID == portfolio.ID {
+			return fmt.Errorf("cannot add %s to itself", portfolio.Name)
+		}
+		if err := portfolio.AddItem(client, item.ID); err != nil {
+			return fmt.Errorf("failed to add %s to portfolio: %w", item.Name, err)
+		}
+		opts.IO.Printf("%s Added %s %s to %s\n", cs.SuccessIcon, item.ResourceType, cs.Bold(item.Name), cs.Bold(portfolio.Name))
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/portfolios/shared"
+	statusshared "github.com/timwehrle/asana/pkg/cmd/projects/status/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type ListOptions struct {
+	cmdutils.BaseOptions
+
+	Owner string
+	JSON  bool
+}
+
+func NewCmdList(f factory.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List portfolios",
+		Long: heredoc.Doc(`
+				List the portfolios you own in your workspace.
+
+				Asana only lists the portfolios of other users to service accounts, so
+				--owner is mostly useful with a service account token.
+			`),
+		Example: heredoc.Doc(`
+				$ asana portfolios list
+				$ asana portfolios list --owner jane@example.com --json
+			`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runList(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Owner, "owner", "o", "me", "Owner name, email, ID or 'me'")
+	cmd.Flags().BoolVar(&opts.JSON, "json", false, "Output portfolios as JSON")
+
+	return cmd
+}
+
+func runList(opts *ListOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	owner := "me"
+	if opts.Owner != "" && opts.Owner != "me" {
+		user, err := cmdutils.ResolveUser(client, cfg, opts.Owner)
+		if err != nil {
+			return err
+		}
+		owner = user.ID
+	}
+
+	ws := &asana.Workspace{ID: cfg.Workspace.ID}
+	portfolios, err := ws.AllPortfolios(client, owner, &asana.Options{Fields: shared.PortfolioFields})
+	if err != nil {
+		return fmt.Errorf("failed to fetch portfolios: %w", err)
+	}
+
+	if opts.JSON {
+		enc := json.NewEncoder(opts.IO.Out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(portfolios)
+	}
+
+	opts.IO.Printf("\nPortfolios in %s:\n\n", cs.Bold(cfg.Workspace.Name))
+	if len(portfolios) == 0 {
+		opts.IO.Println("No portfolios found")
+		return nil
+	}
+
+	for i, p := range portfolios {
+		line := fmt.Sprintf("%d. %s", i+1, cs.Bold(p.Name))
+		if p.CurrentStatusUpdate != nil {
+			line += " " + statusshared.Badge(opts.IO, p.CurrentStatusUpdate.StatusType)
+		}
+		opts.IO.Println(line + " " + cs.Gray(p.ID))
+	}
+
+	return nil
+}
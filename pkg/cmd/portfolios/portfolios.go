@@ -0,0 +1,26 @@
+package portfolios
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/pkg/cmd/portfolios/add"
+	"github.com/timwehrle/asana/pkg/cmd/portfolios/list"
+	"github.com/timwehrle/asana/pkg/cmd/portfolios/remove"
+	"github.com/timwehrle/asana/pkg/cmd/portfolios/view"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+func NewCmdPortfolios(f factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "portfolios <subcommand>",
+		Aliases: []string{"portfolio"},
+		Short:   "Manage portfolios",
+		Long:    "Perform operations related to the portfolios of your workspace.",
+	}
+
+	cmd.AddCommand(list.NewCmdList(f, nil))
+	cmd.AddCommand(view.NewCmdView(f, nil))
+	cmd.AddCommand(add.NewCmdAdd(f, nil))
+	cmd.AddCommand(remove.NewCmdRemove(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,108 @@
+package remove
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/portfolios/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type RemoveOptions struct {
+	cmdutils.BaseOptions
+
+	Portfolio string
+	Items     []string
+}
+
+func NewCmdRemove(f factory.Factory, runF func(*RemoveOptions) error) *cobra.Command {
+	opts := &RemoveOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "remove <portfolio> <item>...",
+		Short: "Remove projects or portfolios from a portfolio",
+		Long: heredoc.Doc(`
+				Remove projects or portfolios from a portfolio, given by name or ID. The
+				items themselves are not changed.
+			`),
+		Example: heredoc.Doc(`
+				$ asana portfolios remove "Roadmap" "Website"
+			`),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Portfolio = args[0]
+			opts.Items = args[1:]
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runRemove(opts)
+		},
+	}
+
+	return cmd
+}
+
+func runRemove(opts *RemoveOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	portfolio, err := shared.ResolvePortfolio(client, cfg, opts.Prompter, opts.Portfolio)
+	if err != nil {
+		return err
+	}
+
+	// Items are matched among the contents of the portfolio
+	contents, err := portfolio.AllItems(client, &asana.Options{Fields: []string{"name", "resource_type"}})
+	if err != nil {
+		return fmt.Errorf("failed to fetch portfolio items: %w", err)
+	}
+
+	items := make([]*asana.PortfolioItem, 0, len(opts.Items))
+	for _, name := range opts.Items {
+		item := findItem(contents, name)
+		if item == nil {
+			return fmt.Errorf("%q is not in portfolio %s", name, portfolio.Name)
+		}
+		items = append(items, item)
+	}
+
+	for _, item := range items {
+		if err := portfolio.RemoveItem(client, item.ID); err != nil {
+			return fmt.Errorf("failed to remove %s from portfolio: %w", item.Name, err)
+		}
+		opts.IO.Printf("%s Removed %s %s from %s\n", cs.SuccessIcon, item.ResourceType, cs.Bold(item.Name), cs.Bold(portfolio.Name))
+	}
+
+	return nil
+}
+
+func findItem(items []*asana.PortfolioItem, nameOrID string) *asana.PortfolioItem {
+	for _, item := range items {
+		if item.ID == nameOrID || strings.EqualFold(item.Name, nameOrID) {
+			return item
+		}
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package shared
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/internal/config"
+	"github.com/timwehrle/asana/internal/prompter"
+	"github.com/timwehrle/asana/pkg/format"
+)
+
+// PortfolioFields are the portfolio fields shown by the portfolio commands.
+var PortfolioFields = []string{
+	"name", "color", "owner.name", "members.name", "start_on", "due_on", "permalink_url",
+	"current_status_update.status_type", "current_status_update.title",
+	"custom_field_settings.custom_field.name",
+}
+
+var gidRe = regexp.MustCompile(`^[0-9]+$`)
+
+// ResolvePortfolio returns the portfolio identified by nameOrID among the
+// portfolios of the current user, or lets the user pick one when nameOrID is
+// empty. Portfolios owned by others can be given by ID.
+func ResolvePortfolio(
+	client *asana.Client,
+	cfg *config.Config,
+	p prompter.Prompter,
+	nameOrID string,
+) (*asana.Portfolio, error) {
+	ws := &asana.Workspace{ID: cfg.Workspace.ID}
+	portfolios, err := ws.AllPortfolios(client, "me", &asana.Options{Fields: []string{"name"}})
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch portfolios: %w", err)
+	}
+
+	if nameOrID != "" {
+		if portfolio := FindPortfolio(portfolios, nameOrID); portfolio != nil {
+			return portfolio, nil
+		}
+
+		if gidRe.MatchString(nameOrID) {
+			portfolio := &asana.Portfolio{ID: nameOrID}
+			if err := portfolio.Fetch(client, &asana.Options{Fields: []string{"name"}}); err == nil {
+				return portfolio, nil
+			} else if !asana.IsNotFoundError(err) {
+				return nil, fmt.Errorf("failed to fetch portfolio: %w", err)
+			}
+		}
+
+		return nil, fmt.Errorf("portfolio %q not found", nameOrID)
+	}
+
+	if len(portfolios) == 0 {
+		return nil, fmt.Errorf("no portfolios found")
+	}
+
+	names := format.MapToStrings(portfolios, func(p *asana.Portfolio) string {
+		return p.Name
+	})
+
+	selected, err := p.Select("Select portfolio: ", names)
+	if err != nil {
+		return nil, fmt.Errorf("portfolio selection failed: %w", err)
+	}
+	return portfolios[selected], nil
+}
+
+// FindPortfolio returns the portfolio with the given ID or name, or nil.
+func FindPortfolio(portfolios []*asana.Portfolio, nameOrID string) *asana.Portfolio {
+	for _, portfolio := range portfolios {
+		if portfolio.ID == nameOrID || strings.EqualFold(portfolio.Name, nameOrID) {
+			return portfolio
+		}
+	}
+	return nil
+}
+
+// ResolveItems returns the projects or portfolios identified by names.
+// Projects of the workspace are matched first, then the portfolios of the
+// current user.
+func ResolveItems(client *asana.Client, cfg *config.Config, names []string) ([]*asana.PortfolioItem, error) {
+	ws := &asana.Workspace{ID: cfg.Workspace.ID}
+
+	projects, err := ws.AllProjects(client)
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch projects: %w", err)
+	}
+
+	var portfolios []*asana.Portfolio
+	items := make([]*asana.PortfolioItem, 0, len(names))
+
+	for _, name := range names {
+		var item *asana.PortfolioItem
+		for _, project := range projects {
+			if project.ID == name || strings.EqualFold(project.Name, name) {
+				item = &asana.PortfolioItem{ID: project.ID, ResourceType: asana.PortfolioItemProject, Name: project.Name}
+				break
+			}
+		}
+
+		if item == nil {
+			if portfolios == nil {
+				if portfolios, err = ws.AllPortfolios(client, "me", &asana.Options{Fields: []string{"name"}}); err != nil {
+					return nil, fmt.Errorf("cannot fetch portfolios: %w", err)
+				}
+			}
+			if portfolio := FindPortfolio(portfolios, name); portfolio != nil {
+				item = &asana.PortfolioItem{ID: portfolio.ID, ResourceType: asana.PortfolioItemPortfolio, Name: portfolio.Name}
+			}
+		}
+
+		if item == nil {
+			return nil, fmt.Errorf("no project or portfolio %q found", name)
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
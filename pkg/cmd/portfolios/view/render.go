@@ -0,0 +1,111 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/timwehrle/asana/internal/api/asana"
+	statusshared "github.com/timwehrle/asana/pkg/cmd/projects/status/shared"
+	"github.com/timwehrle/asana/pkg/format"
+	"github.com/timwehrle/asana/pkg/iostreams"
+)
+
+// barWidth is the number of cells of a progress bar.
+const barWidth = 10
+
+// row is a line of the tree before it is padded into columns.
+type row struct {
+	label string
+	node  *node
+}
+
+// writeTree prints the portfolio header followed by its items as a tree.
+func writeTree(ios *iostreams.IOStreams, p *asana.Portfolio, tree *node) {
+	cs := ios.ColorScheme()
+	out := ios.Out
+
+	fmt.Fprintf(out, "%s %s\n", cs.Bold(tree.Name), cs.Gray(tree.ID))
+
+	var meta []string
+	if p.Owner != nil {
+		meta = append(meta, "Owner: "+p.Owner.Name)
+	}
+	if tree.DueOn != nil {
+		meta = append(meta, "Due "+format.Date(tree.DueOn))
+	}
+	if tree.Status != "" {
+		meta = append(meta, statusshared.TypeName(tree.Status))
+	}
+	if len(meta) > 0 {
+		fmt.Fprintln(out, cs.Gray(strings.Join(meta, " · ")))
+	}
+	fmt.Fprintf(out, "%s %d%% %s\n\n", format.ProgressBar(tree.Completed, tree.Tasks, barWidth), tree.Percent,
+		cs.Gray(fmt.Sprintf("(%d of %d tasks)", tree.Completed, tree.Tasks)))
+
+	if len(tree.Items) == 0 {
+		fmt.Fprintln(out, "No projects in this portfolio")
+		return
+	}
+
+	var rows []row
+	var collect func(nodes []*node, prefix string)
+	collect = func(nodes []*node, prefix string) {
+		for i, n := range nodes {
+			branch, indent := "├── ", "│   "
+			if i == len(nodes)-1 {
+				branch, indent = "└── ", "    "
+			}
+			rows = append(rows, row{label: prefix + branch + n.Name, node: n})
+			collect(n.Items, prefix+indent)
+		}
+	}
+	collect(tree.Items, "")
+
+	labelWidth, dueWidth := 0, 0
+	for _, r := range rows {
+		labelWidth = max(labelWidth, utf8.RuneCountInString(r.label))
+		dueWidth = max(dueWidth, utf8.RuneCountInString(due(r.node)))
+	}
+
+	for _, r := range rows {
+		n := r.node
+		label := r.label + strings.Repeat(" ", labelWidth-utf8.RuneCountInString(r.label))
+		if n.Type == asana.PortfolioItemPortfolio {
+			label = strings.Replace(label, n.Name, cs.Bold(n.Name), 1)
+		}
+
+		if n.Cycle {
+			fmt.Fprintf(out, "%s  %s\n", label, cs.Error("(cycle)"))
+			continue
+		}
+
+		status := statusshared.TypeName(n.Status)
+		if n.Status == "" {
+			status = "No status"
+		}
+		status += strings.Repeat(" ", 9-utf8.RuneCountInString(status))
+		if n.Status != "" {
+			status = strings.Replace(status, statusshared.TypeName(n.Status), statusshared.Badge(ios, n.Status), 1)
+		} else {
+			status = cs.Gray(status)
+		}
+
+		line := label + "  " + status + "  "
+		if dueWidth > 0 {
+			dueDate := due(n)
+			line += cs.Gray(dueDate+strings.Repeat(" ", dueWidth-utf8.RuneCountInString(dueDate))) + "  "
+		}
+
+		fmt.Fprintf(out, "%s%s %3d%%\n", line, format.ProgressBar(n.Completed, n.Tasks, barWidth), n.Percent)
+	}
+}
+
+func due(n *node) string {
+	if n.DueOn == nil {
+		return ""
+	}
+	return "Due " + format.Date(n.DueOn)
+}
+
+// bar draws a progress bar of completed out of total.
@@ -0,0 +1,264 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/portfolios/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+	"github.com/timwehrle/asana/pkg/format"
+)
+
+type ViewOptions struct {
+	cmdutils.BaseOptions
+
+	Portfolio string
+	JSON      bool
+}
+
+// node is a project or portfolio in the portfolio tree. The task counts of
+// a portfolio are rolled up from the projects it contains, at any level,
+// counting each project once.
+type node struct {
+	ID        string           `json:"gid"`
+	Type      string           `json:"type"`
+	Name      string           `json:"name"`
+	Status    asana.StatusType `json:"status,omitempty"`
+	DueOn     *asana.Date      `json:"due_on,omitempty"`
+	Completed int              `json:"completed_tasks"`
+	Tasks     int              `json:"tasks"`
+	Percent   int              `json:"percent_complete"`
+	Items     []*node          `json:"items,omitempty"`
+
+	// Cycle is set if the portfolio already contains itself further up the
+	// tree. Its items are not expanded again.
+	Cycle bool `json:"cycle,omitempty"`
+}
+
+// source loads the contents of a portfolio tree.
+type source struct {
+	items     func(portfolioID string) ([]*asana.PortfolioItem, error)
+	project   func(id string) (*asana.Project, *asana.TaskCount, error)
+	portfolio func(id string) (*asana.Portfolio, error)
+}
+
+func NewCmdView(f factory.Factory, runF func(*ViewOptions) error) *cobra.Command {
+	opts := &ViewOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view [<portfolio>]",
+		Short: "View the projects of a portfolio",
+		Long: heredoc.Doc(`
+				Show the projects in a portfolio with their latest status, due date and
+				percentage of completed tasks. Nested portfolios are expanded, and their
+				progress is rolled up from the projects they contain.
+			`),
+		Example: heredoc.Doc(`
+				$ asana portfolios view "Roadmap"
+				$ asana portfolios view 1204567890123 --json
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Portfolio = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runView(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.JSON, "json", false, "Output the portfolio tree as JSON")
+
+	return cmd
+}
+
+func runView(opts *ViewOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	portfolio, err := shared.ResolvePortfolio(client, cfg, opts.Prompter, opts.Portfolio)
+	if err != nil {
+		return err
+	}
+	if err := portfolio.Fetch(client, &asana.Options{Fields: shared.PortfolioFields}); err != nil {
+		return fmt.Errorf("failed to fetch portfolio: %w", err)
+	}
+
+	tree, err := build(portfolio, apiSource(client))
+	if err != nil {
+		return err
+	}
+
+	if opts.JSON {
+		enc := json.NewEncoder(opts.IO.Out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(tree)
+	}
+
+	writeTree(opts.IO, portfolio, tree)
+	return nil
+}
+
+func apiSource(client *asana.Client) source {
+	return source{
+		items: func(id string) ([]*asana.PortfolioItem, error) {
+			p := &asana.Portfolio{ID: id}
+			items, err := p.AllItems(client, &asana.Options{Fields: []string{"name", "resource_type"}})
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch portfolio items: %w", err)
+			}
+			return items, nil
+		},
+		project: func(id string) (*asana.Project, *asana.TaskCount, error) {
+			p := &asana.Project{ID: id}
+			err := p.Fetch(client, &asana.Options{Fields: []string{"name", "due_on", "current_status_update.status_type"}})
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to fetch project: %w", err)
+			}
+			counts, err := p.TaskCounts(client)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to count tasks of project %q: %w", p.Name, err)
+			}
+			return p, counts, nil
+		},
+		portfolio: func(id string) (*asana.Portfolio, error) {
+			p := &asana.Portfolio{ID: id}
+			err := p.Fetch(client, &asana.Options{Fields: []string{"name", "due_on", "current_status_update.status_type"}})
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch portfolio: %w", err)
+			}
+			return p, nil
+		},
+	}
+}
+
+// build loads the tree of projects and portfolios below portfolio.
+func build(portfolio *asana.Portfolio, src source) (*node, error) {
+	root := portfolioNode(portfolio)
+
+	var expand func(n *node, path []string) error
+	expand = func(n *node, path []string) error {
+		items, err := src.items(n.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			var child *node
+
+			switch item.ResourceType {
+			case asana.PortfolioItemProject:
+				project, counts, err := src.project(item.ID)
+				if err != nil {
+					return err
+				}
+				child = &node{
+					ID:        project.ID,
+					Type:      asana.PortfolioItemProject,
+					Name:      project.Name,
+					Status:    statusType(project.CurrentStatusUpdate),
+					DueOn:     project.DueOn,
+					Completed: counts.NumCompletedTasks,
+					Tasks:     counts.NumTasks,
+				}
+			case asana.PortfolioItemPortfolio:
+				p, err := src.portfolio(item.ID)
+				if err != nil {
+					return err
+				}
+				child = portfolioNode(p)
+				if slices.Contains(path, child.ID) {
+					child.Cycle = true
+				} else if err := expand(child, append(path[:len(path):len(path)], child.ID)); err != nil {
+					return err
+				}
+			default:
+				continue
+			}
+
+			n.Items = append(n.Items, child)
+		}
+
+		return nil
+	}
+
+	if err := expand(root, []string{root.ID}); err != nil {
+		return nil, err
+	}
+
+	rollup(root)
+	return root, nil
+}
+
+func portfolioNode(p *asana.Portfolio) *node {
+	return &node{
+		ID:     p.ID,
+		Type:   asana.PortfolioItemPortfolio,
+		Name:   p.Name,
+		Status: statusType(p.CurrentStatusUpdate),
+		DueOn:  p.DueOn,
+	}
+}
+
+// rollup sets the task counts of every portfolio in the tree from the
+// distinct projects below it.
+func rollup(n *node) {
+	if n.Type != asana.PortfolioItemPortfolio {
+		n.Percent = format.Percent(n.Completed, n.Tasks)
+		return
+	}
+
+	for _, child := range n.Items {
+		rollup(child)
+	}
+
+	projects := make(map[string]*node)
+	var collect func(*node)
+	collect = func(n *node) {
+		for _, child := range n.Items {
+			if child.Type == asana.PortfolioItemProject {
+				projects[child.ID] = child
+			} else {
+				collect(child)
+			}
+		}
+	}
+	collect(n)
+
+	n.Completed, n.Tasks = 0, 0
+	for _, p := range projects {
+		n.Completed += p.Completed
+		n.Tasks += p.Tasks
+	}
+	n.Percent = format.Percent(n.Completed, n.Tasks)
+}
+
+func statusType(update *asana.StatusUpdate) asana.StatusType {
+	if update == nil {
+		return ""
+	}
+	return update.StatusType
+}
@@ -0,0 +1,111 @@
+package view
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/iostreams"
+)
+
+// fakeSource serves portfolio contents from maps.
+func fakeSource(items map[string][]*asana.PortfolioItem, counts map[string]*asana.TaskCount) source {
+	return source{
+		items: func(id string) ([]*asana.PortfolioItem, error) {
+			return items[id], nil
+		},
+		project: func(id string) (*asana.Project, *asana.TaskCount, error) {
+			p := &asana.Project{ID: id}
+			p.Name = "Project " + id
+			if id == "p1" {
+				p.CurrentStatusUpdate = &asana.StatusUpdate{}
+				p.CurrentStatusUpdate.StatusType = asana.StatusAtRisk
+			}
+			return p, counts[id], nil
+		},
+		portfolio: func(id string) (*asana.Portfolio, error) {
+			return &asana.Portfolio{ID: id, Name: "Portfolio " + id}, nil
+		},
+	}
+}
+
+func project(id string) *asana.PortfolioItem {
+	return &asana.PortfolioItem{ID: id, ResourceType: asana.PortfolioItemProject}
+}
+
+func portfolio(id string) *asana.PortfolioItem {
+	return &asana.PortfolioItem{ID: id, ResourceType: asana.PortfolioItemPortfolio}
+}
+
+func TestBuild(t *testing.T) {
+	items := map[string][]*asana.PortfolioItem{
+		"root":   {project("p1"), portfolio("mobile")},
+		"mobile": {project("p2"), project("p1"), portfolio("root")},
+	}
+	counts := map[string]*asana.TaskCount{
+		"p1": {NumTasks: 10, NumCompletedTasks: 5},
+		"p2": {NumTasks: 30, NumCompletedTasks: 3},
+	}
+
+	tree, err := build(&asana.Portfolio{ID: "root", Name: "Roadmap"}, fakeSource(items, counts))
+	require.NoError(t, err)
+
+	// p1 appears twice but is counted once
+	assert.Equal(t, 40, tree.Tasks)
+	assert.Equal(t, 8, tree.Completed)
+	assert.Equal(t, 20, tree.Percent)
+
+	require.Len(t, tree.Items, 2)
+	assert.Equal(t, asana.StatusAtRisk, tree.Items[0].Status)
+	assert.Equal(t, 50, tree.Items[0].Percent)
+
+	mobile := tree.Items[1]
+	assert.Equal(t, "Portfolio mobile", mobile.Name)
+	assert.Equal(t, 40, mobile.Tasks)
+	assert.Equal(t, 20, mobile.Percent)
+
+	require.Len(t, mobile.Items, 3)
+	assert.True(t, mobile.Items[2].Cycle)
+	assert.Empty(t, mobile.Items[2].Items)
+}
+
+func TestBuildError(t *testing.T) {
+	src := fakeSource(map[string][]*asana.PortfolioItem{"root": {project("p1")}}, nil)
+	src.project = func(string) (*asana.Project, *asana.TaskCount, error) {
+		return nil, nil, errors.New("failed to fetch project: boom")
+	}
+
+	_, err := build(&asana.Portfolio{ID: "root"}, src)
+	require.EqualError(t, err, "failed to fetch project: boom")
+}
+
+func TestWriteTree(t *testing.T) {
+	ios, _, out, _ := iostreams.Test()
+
+	items := map[string][]*asana.PortfolioItem{
+		"root":   {project("p1"), portfolio("mobile")},
+		"mobile": {project("p2")},
+	}
+	counts := map[string]*asana.TaskCount{
+		"p1": {NumTasks: 10, NumCompletedTasks: 5},
+		"p2": {NumTasks: 4, NumCompletedTasks: 4},
+	}
+
+	p := &asana.Portfolio{ID: "root", Name: "Roadmap"}
+	tree, err := build(p, fakeSource(items, counts))
+	require.NoError(t, err)
+
+	writeTree(ios, p, tree)
+
+	assert.Equal(t, "Roadmap root\n"+
+		"██████░░░░ 64% (9 of 14 tasks)\n"+
+		"\n"+
+		"├── Project p1        At risk    █████░░░░░  50%\n"+
+		"└── Portfolio mobile  No status  ██████████ 100%\n"+
+		"    └── Project p2    No status  ██████████ 100%\n",
+		iostreams.StripGray(out.String()))
+}
+
+// stripGray removes the color codes of cs.Gray, which are always emitted.
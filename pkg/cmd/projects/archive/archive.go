@@ -0,0 +1,103 @@
+package archive
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type ArchiveOptions struct {
+	cmdutils.BaseOptions
+
+	Project  string
+	Archived bool
+}
+
+// NewCmdArchive returns the command archiving a project.
+func NewCmdArchive(f factory.Factory, runF func(*ArchiveOptions) error) *cobra.Command {
+	return newCmd(f, runF, true, heredoc.Doc(`
+			Archive a project. Archived projects are hidden from lists but keep their
+			tasks, and can be restored with unarchive.
+		`))
+}
+
+// NewCmdUnarchive returns the command restoring an archived project.
+func NewCmdUnarchive(f factory.Factory, runF func(*ArchiveOptions) error) *cobra.Command {
+	return newCmd(f, runF, false, heredoc.Doc(`
+			Restore an archived project.
+		`))
+}
+
+func newCmd(f factory.Factory, runF func(*ArchiveOptions) error, archived bool, long string) *cobra.Command {
+	opts := &ArchiveOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+		Archived: archived,
+	}
+
+	verb, short := "archive", "Archive a project"
+	if !archived {
+		verb, short = "unarchive", "Restore an archived project"
+	}
+
+	cmd := &cobra.Command{
+		Use:     verb + " <project>",
+		Short:   short,
+		Long:    long,
+		Example: fmt.Sprintf("$ asana projects %s \"Website\"", verb),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Project = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runArchive(opts)
+		},
+	}
+
+	return cmd
+}
+
+func runArchive(opts *ArchiveOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	project, err := cmdutils.ResolveProject(client, cfg, opts.Prompter, opts.Project)
+	if err != nil {
+		return err
+	}
+
+	archived := opts.Archived
+	err = project.Update(client, &asana.UpdateProjectRequest{
+		ProjectBase: asana.ProjectBase{Archived: &archived},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update project: %w", err)
+	}
+
+	if archived {
+		opts.IO.Printf("%s Archived project %s\n", cs.SuccessIcon, cs.Bold(project.Name))
+	} else {
+		opts.IO.Printf("%s Restored project %s\n", cs.SuccessIcon, cs.Bold(project.Name))
+	}
+	return nil
+}
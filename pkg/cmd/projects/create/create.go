@@ -0,0 +1,130 @@
+package create
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/projects/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type CreateOptions struct {
+	cmdutils.BaseOptions
+
+	Flags shared.ProjectFlags
+	Team  string
+
+	// Base holds the project fields set by flags.
+	Base asana.ProjectBase
+}
+
+func NewCmdCreate(f factory.Factory, runF func(*CreateOptions) error) *cobra.Command {
+	opts := &CreateOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a project",
+		Long: heredoc.Doc(`
+				Create a project in your workspace.
+
+				In an organization every project belongs to a team, given with --team or
+				picked from a list.
+			`),
+		Example: heredoc.Doc(`
+				$ asana projects create --name "Website relaunch" --team Marketing
+				$ asana projects create --name "Q3 roadmap" --view timeline --start 2025-07-01 --due 2025-09-30
+				$ asana projects create --name "Hiring" --privacy private --color dark-green
+			`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+			if opts.Base, err = opts.Flags.Base(cmd, time.Now()); err != nil {
+				return err
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runCreate(opts)
+		},
+	}
+
+	opts.Flags.Register(cmd)
+	cmd.Flags().StringVarP(&opts.Team, "team", "t", "", "Team name or ID (organizations only)")
+
+	return cmd
+}
+
+func runCreate(opts *CreateOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	if opts.Base.Name == "" {
+		name, err := opts.Prompter.Input("Enter project name: ", "")
+		if err != nil {
+			return fmt.Errorf("failed to read project name: %w", err)
+		}
+		if opts.Base.Name = strings.TrimSpace(name); opts.Base.Name == "" {
+			return errors.New("project name cannot be empty")
+		}
+	}
+
+	req := &asana.CreateProjectRequest{ProjectBase: opts.Base}
+	if opts.Flags.Owner != "" {
+		owner, err := cmdutils.ResolveUser(client, cfg, opts.Flags.Owner)
+		if err != nil {
+			return err
+		}
+		req.Owner = owner.ID
+	}
+
+	ws := &asana.Workspace{ID: cfg.Workspace.ID}
+	if err := ws.Fetch(client); err != nil {
+		return fmt.Errorf("failed to fetch workspace: %w", err)
+	}
+
+	var project *asana.Project
+	if ws.IsOrganization {
+		team, err := cmdutils.ResolveTeam(client, cfg, opts.Prompter, opts.Team)
+		if err != nil {
+			return err
+		}
+		if project, err = team.CreateProject(client, req); err != nil {
+			return fmt.Errorf("failed to create project: %w", err)
+		}
+	} else {
+		if opts.Team != "" {
+			return fmt.Errorf("%s is not an organization and has no teams", ws.Name)
+		}
+		req.Workspace = ws.ID
+		if project, err = client.CreateProject(req); err != nil {
+			return fmt.Errorf("failed to create project: %w", err)
+		}
+	}
+
+	opts.IO.Printf("%s Created project %s %s\n", cs.SuccessIcon, cs.Bold(project.Name), cs.Gray(project.ID))
+	return nil
+}
@@ -0,0 +1,92 @@
+package delete
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type DeleteOptions struct {
+	cmdutils.BaseOptions
+
+	Project string
+	Yes     bool
+}
+
+func NewCmdDelete(f factory.Factory, runF func(*DeleteOptions) error) *cobra.Command {
+	opts := &DeleteOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete <project>",
+		Short: "Delete a project",
+		Long: heredoc.Doc(`
+				Delete a project. Tasks that only belong to this project are deleted with
+				it. Consider archiving the project instead.
+			`),
+		Example: heredoc.Doc(`
+				$ asana projects delete "Old website"
+				$ asana projects delete 1204567890123 --yes
+			`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Project = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runDelete(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func runDelete(opts *DeleteOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	project, err := cmdutils.ResolveProject(client, cfg, opts.Prompter, opts.Project)
+	if err != nil {
+		return err
+	}
+
+	if !opts.Yes {
+		confirmed, err := opts.Prompter.Confirm(
+			fmt.Sprintf("Delete project %s and the tasks only it contains?", project.Name), "No")
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return nil
+		}
+	}
+
+	if err := project.Delete(client); err != nil {
+		return fmt.Errorf("failed to delete project: %w", err)
+	}
+
+	opts.IO.Printf("%s Deleted project %s\n", cs.SuccessIcon, cs.Bold(project.Name))
+	return nil
+}
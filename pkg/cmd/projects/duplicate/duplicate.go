@@ -0,0 +1,268 @@
+package duplicate
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+// pollInterval is the time between two checks of the duplication job.
+const pollInterval = 2 * time.Second
+
+// taskParts are the parts copied by the "tasks" shorthand of --include.
+var taskParts = []string{
+	asana.DuplicateTaskAssignee,
+	asana.DuplicateTaskAttachments,
+	asana.DuplicateTaskDates,
+	asana.DuplicateTaskDependencies,
+	asana.DuplicateTaskFollowers,
+	asana.DuplicateTaskNotes,
+	asana.DuplicateTaskProjects,
+	asana.DuplicateTaskSubtasks,
+	asana.DuplicateTaskTags,
+}
+
+var parts = append([]string{
+	asana.DuplicateAllocations,
+	asana.DuplicateForms,
+	asana.DuplicateMembers,
+	asana.DuplicateNotes,
+}, taskParts...)
+
+type DuplicateOptions struct {
+	cmdutils.BaseOptions
+
+	Project      string
+	Name         string
+	Team         string
+	Include      []string
+	ShiftDays    int
+	SkipWeekends bool
+	NoWait       bool
+	Timeout      time.Duration
+
+	// Sleep waits between two polls of the job. It is replaced in tests.
+	Sleep func(time.Duration)
+}
+
+func NewCmdDuplicate(f factory.Factory, runF func(*DuplicateOptions) error) *cobra.Command {
+	opts := &DuplicateOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+		Sleep: time.Sleep,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "duplicate [<project>]",
+		Short: "Copy a project",
+		Long: heredoc.Docf(`
+				Copy a project with its sections and tasks.
+
+				Asana copies projects in the background. The command waits until the copy
+				is finished unless --no-wait is given.
+
+				--include chooses what else is copied: %[1]s.
+				"tasks" is short for all task_ parts.
+
+				--shift-dates moves the start date of the copy, or its due date if it has
+				no start date, by the given number of days. Task dates move along with it.
+			`, strings.Join(parts, ", ")),
+		Example: heredoc.Doc(`
+				$ asana projects duplicate "Onboarding template" --name "Onboarding Jane"
+				$ asana projects duplicate "Sprint 12" --include tasks,members --shift-dates 14 --skip-weekends
+				$ asana projects duplicate 1204567890123 --team Design --no-wait
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Project = args[0]
+			}
+
+			var err error
+			if opts.Include, err = expandInclude(opts.Include); err != nil {
+				return err
+			}
+			if opts.SkipWeekends && opts.ShiftDays == 0 {
+				return errors.New("--skip-weekends requires --shift-dates")
+			}
+			if opts.ShiftDays != 0 && !slices.Contains(opts.Include, asana.DuplicateTaskDates) {
+				opts.Include = append(opts.Include, asana.DuplicateTaskDates)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runDuplicate(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Name, "name", "n", "", "Name of the copy (default \"Copy of <project>\")")
+	cmd.Flags().StringVarP(&opts.Team, "team", "t", "", "Team name or ID for the copy (defaults to the team of the project)")
+	cmd.Flags().StringSliceVarP(&opts.Include, "include", "i", nil, "Parts to copy, such as tasks, members or notes")
+	cmd.Flags().IntVar(&opts.ShiftDays, "shift-dates", 0, "Shift the dates of the copy by this many days")
+	cmd.Flags().BoolVar(&opts.SkipWeekends, "skip-weekends", false, "Skip weekends when shifting dates")
+	cmd.Flags().BoolVar(&opts.NoWait, "no-wait", false, "Return without waiting for the copy to finish")
+	cmd.Flags().DurationVar(&opts.Timeout, "timeout", 10*time.Minute, "How long to wait for the copy to finish")
+
+	return cmd
+}
+
+func runDuplicate(opts *DuplicateOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	project, err := cmdutils.ResolveProject(client, cfg, opts.Prompter, opts.Project)
+	if err != nil {
+		return err
+	}
+
+	req := &asana.DuplicateProjectRequest{
+		Name:    opts.Name,
+		Include: opts.Include,
+	}
+	if req.Name == "" {
+		req.Name = "Copy of " + project.Name
+	}
+
+	if opts.Team != "" {
+		team, err := cmdutils.ResolveTeam(client, cfg, opts.Prompter, opts.Team)
+		if err != nil {
+			return err
+		}
+		req.Team = team.ID
+	}
+
+	if opts.ShiftDays != 0 {
+		err := project.Fetch(client, &asana.Options{Fields: []string{"name", "start_on", "due_on"}})
+		if err != nil {
+			return fmt.Errorf("failed to fetch project: %w", err)
+		}
+		if req.ScheduleDates, err = scheduleDates(project, opts.ShiftDays, opts.SkipWeekends); err != nil {
+			return err
+		}
+	}
+
+	job, err := project.Duplicate(client, req)
+	if err != nil {
+		return fmt.Errorf("failed to duplicate project: %w", err)
+	}
+
+	if opts.NoWait {
+		opts.IO.Printf("%s Started copying %s as %s (job %s)\n",
+			cs.SuccessIcon, cs.Bold(project.Name), cs.Bold(req.Name), job.ID)
+		return nil
+	}
+
+	if opts.IO.IsStderrTTY {
+		fmt.Fprintf(opts.IO.ErrOut, "Copying %s, this may take a while...\n", project.Name)
+	}
+
+	fetch := func(j *asana.Job) error {
+		return j.Fetch(client, &asana.Options{Fields: []string{"status", "new_project.name"}})
+	}
+	if err := wait(job, fetch, opts.Sleep, opts.Timeout); err != nil {
+		return err
+	}
+
+	if job.NewProject == nil {
+		opts.IO.Printf("%s Copied %s\n", cs.SuccessIcon, cs.Bold(project.Name))
+		return nil
+	}
+
+	opts.IO.Printf("%s Copied %s to %s %s\n",
+		cs.SuccessIcon, cs.Bold(project.Name), cs.Bold(job.NewProject.Name), cs.Gray(job.NewProject.ID))
+	return nil
+}
+
+// expandInclude validates the --include values, replacing the "tasks"
+// shorthand with all task parts and dropping duplicates.
+func expandInclude(values []string) ([]string, error) {
+	var include []string
+
+	add := func(part string) {
+		if !slices.Contains(include, part) {
+			include = append(include, part)
+		}
+	}
+
+	for _, v := range values {
+		v = strings.ToLower(strings.TrimSpace(v))
+		switch {
+		case v == "tasks":
+			for _, part := range taskParts {
+				add(part)
+			}
+		case slices.Contains(parts, v):
+			add(v)
+		default:
+			return nil, fmt.Errorf("invalid value for --include: %q (must be tasks or one of %s)",
+				v, strings.Join(parts, ", "))
+		}
+	}
+
+	return include, nil
+}
+
+// scheduleDates anchors the copy on the start date of the project shifted by
+// days, or on its due date if it has no start date.
+func scheduleDates(project *asana.Project, days int, skipWeekends bool) (*asana.ScheduleDates, error) {
+	shift := func(d *asana.Date) *asana.Date {
+		shifted := asana.Date(time.Time(*d).AddDate(0, 0, days))
+		return &shifted
+	}
+
+	switch {
+	case project.StartOn != nil:
+		return &asana.ScheduleDates{ShouldSkipWeekends: skipWeekends, StartOn: shift(project.StartOn)}, nil
+	case project.DueOn != nil:
+		return &asana.ScheduleDates{ShouldSkipWeekends: skipWeekends, DueOn: shift(project.DueOn)}, nil
+	default:
+		return nil, fmt.Errorf("project %s has no start or due date to shift", project.Name)
+	}
+}
+
+// wait polls the job until it is done or the timeout has passed.
+func wait(job *asana.Job, fetch func(*asana.Job) error, sleep func(time.Duration), timeout time.Duration) error {
+	var waited time.Duration
+
+	for !job.Done() {
+		if waited >= timeout {
+			return fmt.Errorf("timed out after %s waiting for job %s, the copy may still finish", timeout, job.ID)
+		}
+
+		sleep(pollInterval)
+		waited += pollInterval
+
+		if err := fetch(job); err != nil {
+			return fmt.Errorf("failed to check job status: %w", err)
+		}
+	}
+
+	if job.Status == asana.JobFailed {
+		return fmt.Errorf("job %s failed to copy the project", job.ID)
+	}
+
+	return nil
+}
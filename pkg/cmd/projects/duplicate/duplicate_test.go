@@ -0,0 +1,168 @@
+package duplicate
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+func date(s string) *asana.Date {
+	t, _ := time.Parse(time.DateOnly, s)
+	d := asana.Date(t)
+	return &d
+}
+
+func TestNewCmdDuplicate(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		wantInclude []string
+		wantShift   int
+		wantErr     string
+	}{
+		{
+			name: "defaults",
+			args: []string{"Template"},
+		},
+		{
+			name:        "members and notes",
+			args:        []string{"--include", "members,notes,members"},
+			wantInclude: []string{"members", "notes"},
+		},
+		{
+			name:        "tasks shorthand",
+			args:        []string{"-i", "tasks", "-i", "task_notes"},
+			wantInclude: taskParts,
+		},
+		{
+			name:        "shift adds task dates",
+			args:        []string{"--include", "members", "--shift-dates", "7"},
+			wantInclude: []string{"members", "task_dates"},
+			wantShift:   7,
+		},
+		{
+			name:    "invalid part",
+			args:    []string{"--include", "comments"},
+			wantErr: `invalid value for --include: "comments"`,
+		},
+		{
+			name:    "skip weekends without shift",
+			args:    []string{"--skip-weekends"},
+			wantErr: "--skip-weekends requires --shift-dates",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, _, _ := factory.NewTestFactory()
+
+			var got *DuplicateOptions
+			cmd := NewCmdDuplicate(f, func(opts *DuplicateOptions) error {
+				got = opts
+				return nil
+			})
+			cmd.SetArgs(tt.args)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantInclude, got.Include)
+			assert.Equal(t, tt.wantShift, got.ShiftDays)
+		})
+	}
+}
+
+func TestScheduleDates(t *testing.T) {
+	project := &asana.Project{}
+	project.Name = "Sprint"
+
+	_, err := scheduleDates(project, 7, false)
+	require.EqualError(t, err, "project Sprint has no start or due date to shift")
+
+	project.DueOn = date("2025-03-28")
+	got, err := scheduleDates(project, 7, true)
+	require.NoError(t, err)
+	assert.Equal(t, &asana.ScheduleDates{ShouldSkipWeekends: true, DueOn: date("2025-04-04")}, got)
+
+	project.StartOn = date("2025-03-03")
+	got, err = scheduleDates(project, -3, false)
+	require.NoError(t, err)
+	assert.Equal(t, &asana.ScheduleDates{StartOn: date("2025-02-28")}, got)
+}
+
+func TestWait(t *testing.T) {
+	tests := []struct {
+		name      string
+		statuses  []asana.JobStatus
+		fetchErr  error
+		timeout   time.Duration
+		wantPolls int
+		wantErr   string
+	}{
+		{
+			name:      "succeeds",
+			statuses:  []asana.JobStatus{asana.JobInProgress, asana.JobInProgress, asana.JobSucceeded},
+			timeout:   time.Minute,
+			wantPolls: 3,
+		},
+		{
+			name:      "fails",
+			statuses:  []asana.JobStatus{asana.JobFailed},
+			timeout:   time.Minute,
+			wantPolls: 1,
+			wantErr:   "job 1 failed to copy the project",
+		},
+		{
+			name:      "times out",
+			statuses:  []asana.JobStatus{asana.JobInProgress, asana.JobInProgress, asana.JobInProgress},
+			timeout:   2 * pollInterval,
+			wantPolls: 2,
+			wantErr:   "timed out after 4s waiting for job 1, the copy may still finish",
+		},
+		{
+			name:      "fetch error",
+			fetchErr:  errors.New("boom"),
+			timeout:   time.Minute,
+			wantPolls: 1,
+			wantErr:   "failed to check job status: boom",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := &asana.Job{ID: "1", Status: asana.JobNotStarted}
+
+			polls := 0
+			fetch := func(j *asana.Job) error {
+				polls++
+				if tt.fetchErr != nil {
+					return tt.fetchErr
+				}
+				j.Status = tt.statuses[polls-1]
+				return nil
+			}
+
+			var slept time.Duration
+			err := wait(job, fetch, func(d time.Duration) { slept += d }, tt.timeout)
+
+			assert.Equal(t, tt.wantPolls, polls)
+			assert.Equal(t, time.Duration(tt.wantPolls)*pollInterval, slept)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package edit
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/projects/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type EditOptions struct {
+	cmdutils.BaseOptions
+
+	Project string
+	Flags   shared.ProjectFlags
+
+	// Base holds the project fields set by flags.
+	Base asana.ProjectBase
+
+	// Clear holds the project fields removed by flags.
+	Clear []string
+}
+
+func NewCmdEdit(f factory.Factory, runF func(*EditOptions) error) *cobra.Command {
+	opts := &EditOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "edit [<project>]",
+		Short: "Edit a project",
+		Long: heredoc.Doc(`
+				Change the name, description, color, default view, privacy, dates, icon or
+				owner of a project. Only the given flags are changed.
+
+				Use --notes "" to remove the description and --start none or --due none to
+				remove a date.
+
+				Inside a linked directory the linked project is used instead of prompting.
+			`),
+		Example: heredoc.Doc(`
+				$ asana projects edit "Website" --name "Website relaunch" --due 2025-12-01
+				$ asana projects edit --owner me --view board
+				$ asana projects edit "Website" --due none
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Project = args[0]
+			}
+
+			var err error
+			if opts.Base, err = opts.Flags.Base(cmd, time.Now()); err != nil {
+				return err
+			}
+			opts.Clear = opts.Flags.Clear(cmd)
+			if cmd.Flags().NFlag() == 0 {
+				return errors.New("specify at least one field to change")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runEdit(opts)
+		},
+	}
+
+	opts.Flags.Register(cmd)
+
+	return cmd
+}
+
+func runEdit(opts *EditOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	project, err := cmdutils.ResolveProject(client, cfg, opts.Prompter, opts.Project)
+	if err != nil {
+		return err
+	}
+
+	req := &asana.UpdateProjectRequest{ProjectBase: opts.Base, Clear: opts.Clear}
+	if opts.Flags.Owner != "" {
+		owner, err := cmdutils.ResolveUser(client, cfg, opts.Flags.Owner)
+		if err != nil {
+			return err
+		}
+		req.Owner = owner.ID
+	}
+
+	if err := project.Update(client, req); err != nil {
+		return fmt.Errorf("failed to update project: %w", err)
+	}
+
+	opts.IO.Printf("%s Updated project %s\n", cs.SuccessIcon, cs.Bold(project.Name))
+	return nil
+}
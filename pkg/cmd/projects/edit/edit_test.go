@@ -0,0 +1,116 @@
+package edit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/convert"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+func date(s string) *asana.Date {
+	d, _ := convert.ToDate(s, time.DateOnly)
+	return d
+}
+
+func TestNewCmdEdit(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		wantProject string
+		wantBase    func() asana.ProjectBase
+		wantClear   []string
+		wantErr     string
+	}{
+		{
+			name:        "name and dates",
+			args:        []string{"Website", "--name", " Relaunch ", "--start", "2025-07-01", "--due", "2025-09-30"},
+			wantProject: "Website",
+			wantBase: func() asana.ProjectBase {
+				return asana.ProjectBase{Name: "Relaunch", StartOn: date("2025-07-01"), DueOn: date("2025-09-30")}
+			},
+		},
+		{
+			name: "view, color and privacy",
+			args: []string{"--view", "board", "--color", "dark-blue", "--privacy", "team"},
+			wantBase: func() asana.ProjectBase {
+				return asana.ProjectBase{DefaultView: asana.ViewBoard, Color: "dark-blue", PrivacySetting: asana.PrivacySettingTeam}
+			},
+		},
+		{
+			name:     "owner only",
+			args:     []string{"--owner", "me"},
+			wantBase: func() asana.ProjectBase { return asana.ProjectBase{} },
+		},
+		{
+			name:      "clear notes and dates",
+			args:      []string{"--notes", "", "--start", "none", "--due", "None"},
+			wantBase:  func() asana.ProjectBase { return asana.ProjectBase{} },
+			wantClear: []string{"notes", "start_on", "due_on"},
+		},
+		{
+			name:    "no flags",
+			args:    []string{"Website"},
+			wantErr: "specify at least one field to change",
+		},
+		{
+			name:    "empty name",
+			args:    []string{"--name", " "},
+			wantErr: "project name cannot be empty",
+		},
+		{
+			name:    "invalid color",
+			args:    []string{"--color", "mauve"},
+			wantErr: `invalid value "mauve" for flag --color`,
+		},
+		{
+			name:    "invalid privacy",
+			args:    []string{"--privacy", "secret"},
+			wantErr: `invalid value "secret" for flag --privacy`,
+		},
+		{
+			name:    "invalid start",
+			args:    []string{"--start", "soon"},
+			wantErr: `invalid date for --start: "soon" (must be YYYY-MM-DD)`,
+		},
+		{
+			name:    "due before start",
+			args:    []string{"--start", "2025-07-01", "--due", "2025-06-30"},
+			wantErr: "due date cannot be before the start date",
+		},
+		{
+			name:    "too many args",
+			args:    []string{"a", "b", "--name", "x"},
+			wantErr: "accepts at most 1 arg(s), received 2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, _, _ := factory.NewTestFactory()
+
+			var got *EditOptions
+			cmd := NewCmdEdit(f, func(opts *EditOptions) error {
+				got = opts
+				return nil
+			})
+			cmd.SetArgs(tt.args)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantProject, got.Project)
+			assert.Equal(t, tt.wantBase(), got.Base)
+			assert.Equal(t, tt.wantClear, got.Clear)
+		})
+	}
+}
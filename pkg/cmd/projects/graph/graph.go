@@ -0,0 +1,265 @@
+package graph
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type GraphOptions struct {
+	cmdutils.BaseOptions
+
+	Project  string
+	Format   string
+	Subtasks bool
+
+	now func() time.Time
+}
+
+// taskFields are the task fields needed to build the graph.
+var taskFields = []string{
+	"name", "completed", "due_on", "due_at", "num_subtasks", "parent",
+	"dependencies.name", "dependencies.completed",
+	"dependents.name", "dependents.completed",
+	"memberships.project", "memberships.section",
+}
+
+// cluster is a section together with the tasks drawn inside it.
+type cluster struct {
+	Section *asana.Section
+	Tasks   []*asana.Task
+}
+
+// edge points from a task to a task it blocks, or from a parent to one of
+// its subtasks.
+type edge struct {
+	From, To string
+	Subtask  bool
+}
+
+// graph is the dependency graph of a project.
+type graph struct {
+	Title    string
+	Clusters []*cluster
+	// Loose holds project tasks outside of any section.
+	Loose []*asana.Task
+	// External holds tasks of other projects that are connected to the
+	// project's tasks.
+	External []*asana.Task
+	Edges    []edge
+}
+
+func NewCmdGraph(f factory.Factory, runF func(*GraphOptions) error) *cobra.Command {
+	opts := &GraphOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+		now: time.Now,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "graph [<project>]",
+		Short: "Export the dependency graph of a project",
+		Long: heredoc.Doc(`
+				Print the task dependencies of a project as a Graphviz DOT or Mermaid graph.
+
+				Tasks are grouped by section. An arrow points from a task to the tasks it
+				blocks. Completed tasks are drawn in green, overdue tasks in red, and tasks
+				of other projects with a dashed border.
+
+				With --subtasks, subtasks are included and connected to their parent with
+				a dashed line.
+
+				Inside a linked directory the linked project is used instead of prompting.
+			`),
+		Example: heredoc.Doc(`
+				$ asana projects graph "Website" | dot -Tsvg > website.svg
+				$ asana projects graph --format mermaid --subtasks > graph.mmd
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Project = args[0]
+			}
+			if opts.Format != "dot" && opts.Format != "mermaid" {
+				return fmt.Errorf("invalid format %q: expected dot or mermaid", opts.Format)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runGraph(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Format, "format", "f", "dot", "Output format: dot or mermaid")
+	cmd.Flags().BoolVar(&opts.Subtasks, "subtasks", false, "Include subtasks")
+
+	return cmd
+}
+
+func runGraph(opts *GraphOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	project, err := cmdutils.ResolveProject(client, cfg, opts.Prompter, opts.Project)
+	if err != nil {
+		return err
+	}
+
+	sections, err := cmdutils.AllSections(client, project)
+	if err != nil {
+		return fmt.Errorf("failed to fetch sections: %w", err)
+	}
+
+	tasks, err := cmdutils.AllProjectTasks(client, project, taskFields)
+	if err != nil {
+		return fmt.Errorf("failed to fetch tasks for project %q: %w", project.Name, err)
+	}
+
+	if opts.Subtasks {
+		if tasks, err = withSubtasks(client, tasks); err != nil {
+			return err
+		}
+	}
+
+	g := buildGraph(project, sections, tasks)
+	if opts.Format == "mermaid" {
+		writeMermaid(opts.IO.Out, g, opts.now())
+	} else {
+		writeDOT(opts.IO.Out, g, opts.now())
+	}
+
+	return nil
+}
+
+// withSubtasks appends the subtasks of tasks, at every level, to tasks.
+// Subtasks that are also in the project are listed once.
+func withSubtasks(client *asana.Client, tasks []*asana.Task) ([]*asana.Task, error) {
+	seen := make(map[string]bool, len(tasks))
+	for _, t := range tasks {
+		seen[t.ID] = true
+	}
+
+	for i := 0; i < len(tasks); i++ {
+		task := tasks[i]
+		if task.NumSubtasks == 0 {
+			continue
+		}
+
+		options := &asana.Options{Limit: 100, Fields: taskFields}
+		for {
+			batch, nextPage, err := task.Subtasks(client, options)
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch subtasks of %q: %w", task.Name, err)
+			}
+
+			for _, sub := range batch {
+				if seen[sub.ID] {
+					continue
+				}
+				seen[sub.ID] = true
+				sub.Parent = &asana.Task{ID: task.ID}
+				tasks = append(tasks, sub)
+			}
+
+			if nextPage == nil || nextPage.Offset == "" {
+				break
+			}
+			options.Offset = nextPage.Offset
+		}
+	}
+
+	return tasks, nil
+}
+
+// buildGraph groups tasks into their sections and collects the edges
+// between them. Subtasks outside the project are drawn in the section of
+// their parent.
+func buildGraph(project *asana.Project, sections []*asana.Section, tasks []*asana.Task) *graph {
+	g := &graph{Title: project.Name}
+
+	nodes := make(map[string]*asana.Task, len(tasks))
+	for _, t := range tasks {
+		nodes[t.ID] = t
+	}
+
+	var sectionOf func(t *asana.Task) string
+	sectionOf = func(t *asana.Task) string {
+		for _, m := range t.Memberships {
+			if m.Project != nil && m.Project.ID == project.ID && m.Section != nil {
+				return m.Section.ID
+			}
+		}
+		if t.Parent != nil {
+			if parent, ok := nodes[t.Parent.ID]; ok {
+				return sectionOf(parent)
+			}
+		}
+		return ""
+	}
+
+	clusters := make(map[string]*cluster, len(sections))
+	for _, s := range sections {
+		clusters[s.ID] = &cluster{Section: s}
+	}
+	for _, t := range tasks {
+		if c, ok := clusters[sectionOf(t)]; ok {
+			c.Tasks = append(c.Tasks, t)
+		} else {
+			g.Loose = append(g.Loose, t)
+		}
+	}
+	for _, s := range sections {
+		if c := clusters[s.ID]; len(c.Tasks) > 0 {
+			g.Clusters = append(g.Clusters, c)
+		}
+	}
+
+	seenEdges := make(map[edge]bool)
+	external := make(map[string]bool)
+	addEdge := func(e edge, other *asana.Task) {
+		if seenEdges[e] {
+			return
+		}
+		seenEdges[e] = true
+		g.Edges = append(g.Edges, e)
+
+		if _, ok := nodes[other.ID]; !ok && !external[other.ID] {
+			external[other.ID] = true
+			g.External = append(g.External, other)
+		}
+	}
+
+	for _, t := range tasks {
+		if t.Parent != nil {
+			if _, ok := nodes[t.Parent.ID]; ok {
+				addEdge(edge{From: t.Parent.ID, To: t.ID, Subtask: true}, t)
+			}
+		}
+		for _, dep := range t.Dependencies {
+			addEdge(edge{From: dep.ID, To: t.ID}, dep)
+		}
+		for _, dep := range t.Dependents {
+			addEdge(edge{From: t.ID, To: dep.ID}, dep)
+		}
+	}
+
+	return g
+}
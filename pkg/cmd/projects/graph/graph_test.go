@@ -0,0 +1,185 @@
+package graph
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+func TestNewCmdGraph(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    GraphOptions
+		wantErr string
+	}{
+		{name: "defaults", args: []string{}, want: GraphOptions{Format: "dot"}},
+		{name: "project and mermaid", args: []string{"Website", "-f", "mermaid", "--subtasks"}, want: GraphOptions{Project: "Website", Format: "mermaid", Subtasks: true}},
+		{name: "invalid format", args: []string{"--format", "svg"}, wantErr: `invalid format "svg": expected dot or mermaid`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, _, _ := factory.NewTestFactory()
+
+			var got *GraphOptions
+			cmd := NewCmdGraph(f, func(opts *GraphOptions) error {
+				got = opts
+				return nil
+			})
+			cmd.SetArgs(tt.args)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.Project, got.Project)
+			assert.Equal(t, tt.want.Format, got.Format)
+			assert.Equal(t, tt.want.Subtasks, got.Subtasks)
+		})
+	}
+}
+
+func newTask(id, name, section string) *asana.Task {
+	t := &asana.Task{ID: id}
+	t.Name = name
+	if section != "" {
+		t.Memberships = []*asana.Membership{{
+			Project: &asana.Project{ID: "p1"},
+			Section: &asana.Section{ID: section},
+		}}
+	}
+	return t
+}
+
+func testGraph() *graph {
+	done := true
+	due := asana.Date(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))
+
+	design := newTask("1", `Design "v2"`, "s1")
+	design.Completed = &done
+
+	build := newTask("2", "Build", "s2")
+	build.DueOn = &due
+	build.Dependencies = []*asana.Task{{ID: "1"}, {ID: "9"}}
+	build.Dependencies[1].Name = "Legal review"
+
+	tests := newTask("3", "Tests", "")
+	tests.Parent = &asana.Task{ID: "2"}
+	tests.Dependents = []*asana.Task{{ID: "4"}}
+
+	release := newTask("4", "Release", "")
+	release.Dependencies = []*asana.Task{{ID: "3"}}
+
+	var sections []*asana.Section
+	for _, name := range []string{"Design", "Build", "Empty"} {
+		s := &asana.Section{ID: fmt.Sprintf("s%d", len(sections)+1)}
+		s.Name = name
+		sections = append(sections, s)
+	}
+
+	project := &asana.Project{ID: "p1"}
+	project.Name = "Website"
+
+	return buildGraph(project, sections, []*asana.Task{design, build, tests, release})
+}
+
+func TestBuildGraph(t *testing.T) {
+	g := testGraph()
+
+	require.Len(t, g.Clusters, 2)
+	assert.Equal(t, "Design", g.Clusters[0].Section.Name)
+	assert.Equal(t, "Build", g.Clusters[1].Section.Name)
+	// The subtask is drawn in the section of its parent
+	assert.Equal(t, []string{"2", "3"}, ids(g.Clusters[1].Tasks))
+	assert.Equal(t, []string{"4"}, ids(g.Loose))
+	assert.Equal(t, []string{"9"}, ids(g.External))
+
+	assert.Equal(t, []edge{
+		{From: "1", To: "2"},
+		{From: "9", To: "2"},
+		{From: "2", To: "3", Subtask: true},
+		{From: "3", To: "4"},
+	}, g.Edges)
+}
+
+func ids(tasks []*asana.Task) []string {
+	var out []string
+	for _, t := range tasks {
+		out = append(out, t.ID)
+	}
+	return out
+}
+
+func TestWriteDOT(t *testing.T) {
+	var buf bytes.Buffer
+	writeDOT(&buf, testGraph(), time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, heredoc.Doc(`
+		digraph "Website" {
+		  rankdir=LR;
+		  node [shape=box, style="rounded,filled", fillcolor="white"];
+
+		  subgraph "cluster_s1" {
+		    label="Design";
+		    "1" [label="Design \"v2\"", fillcolor="#d4edda", color="#28a745", fontcolor="#555555"];
+		  }
+
+		  subgraph "cluster_s2" {
+		    label="Build";
+		    "2" [label="Build\nDue 2025-03-01", fillcolor="#f8d7da", color="#dc3545"];
+		    "3" [label="Tests"];
+		  }
+
+		  "4" [label="Release"];
+		  "9" [label="Legal review", style="rounded,filled,dashed"];
+
+		  "1" -> "2";
+		  "9" -> "2";
+		  "2" -> "3" [style=dashed, arrowhead=none];
+		  "3" -> "4";
+		}
+	`), buf.String())
+}
+
+func TestWriteMermaid(t *testing.T) {
+	var buf bytes.Buffer
+	writeMermaid(&buf, testGraph(), time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, heredoc.Doc(`
+		---
+		title: "Website"
+		---
+		flowchart LR
+		  subgraph ss1["Design"]
+		    t1["Design #quot;v2#quot;"]
+		  end
+		  subgraph ss2["Build"]
+		    t2["Build<br/>Due 2025-03-01"]
+		    t3["Tests"]
+		  end
+		  t4["Release"]
+		  t9["Legal review"]
+		  t1 --> t2
+		  t9 --> t2
+		  t2 -.- t3
+		  t3 --> t4
+		  classDef completed fill:#d4edda,stroke:#28a745,color:#555555
+		  classDef overdue fill:#f8d7da,stroke:#dc3545
+		  classDef external stroke-dasharray:5 5
+		  class t1 completed
+		  class t2 overdue
+		  class t9 external
+	`), buf.String())
+}
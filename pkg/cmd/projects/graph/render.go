@@ -0,0 +1,163 @@
+package graph
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+)
+
+const (
+	statusOpen      = ""
+	statusCompleted = "completed"
+	statusOverdue   = "overdue"
+)
+
+func status(t *asana.Task, now time.Time) string {
+	switch {
+	case t.Completed != nil && *t.Completed:
+		return statusCompleted
+	case cmdutils.IsOverdue(t, now):
+		return statusOverdue
+	default:
+		return statusOpen
+	}
+}
+
+// label returns the lines shown in the node of a task.
+func label(t *asana.Task) []string {
+	lines := []string{t.Name}
+	if t.DueAt != nil {
+		lines = append(lines, "Due "+t.DueAt.Local().Format("2006-01-02 15:04"))
+	} else if t.DueOn != nil {
+		lines = append(lines, "Due "+time.Time(*t.DueOn).Format(time.DateOnly))
+	}
+	return lines
+}
+
+func writeDOT(w io.Writer, g *graph, now time.Time) {
+	fmt.Fprintf(w, "digraph %s {\n", dotQuote(g.Title))
+	fmt.Fprintln(w, "  rankdir=LR;")
+	fmt.Fprintln(w, `  node [shape=box, style="rounded,filled", fillcolor="white"];`)
+
+	node := func(indent string, t *asana.Task, external bool) {
+		attrs := []string{"label=" + dotQuote(strings.Join(label(t), "\n"))}
+		switch status(t, now) {
+		case statusCompleted:
+			attrs = append(attrs, `fillcolor="#d4edda"`, `color="#28a745"`, `fontcolor="#555555"`)
+		case statusOverdue:
+			attrs = append(attrs, `fillcolor="#f8d7da"`, `color="#dc3545"`)
+		}
+		if external {
+			attrs = append(attrs, `style="rounded,filled,dashed"`)
+		}
+		fmt.Fprintf(w, "%s%s [%s];\n", indent, dotQuote(t.ID), strings.Join(attrs, ", "))
+	}
+
+	for _, c := range g.Clusters {
+		fmt.Fprintln(w)
+		fmt.Fprintf(w, "  subgraph %s {\n", dotQuote("cluster_"+c.Section.ID))
+		fmt.Fprintf(w, "    label=%s;\n", dotQuote(c.Section.Name))
+		for _, t := range c.Tasks {
+			node("    ", t, false)
+		}
+		fmt.Fprintln(w, "  }")
+	}
+
+	if len(g.Loose) > 0 || len(g.External) > 0 {
+		fmt.Fprintln(w)
+	}
+	for _, t := range g.Loose {
+		node("  ", t, false)
+	}
+	for _, t := range g.External {
+		node("  ", t, true)
+	}
+
+	if len(g.Edges) > 0 {
+		fmt.Fprintln(w)
+	}
+	for _, e := range g.Edges {
+		attrs := ""
+		if e.Subtask {
+			attrs = " [style=dashed, arrowhead=none]"
+		}
+		fmt.Fprintf(w, "  %s -> %s%s;\n", dotQuote(e.From), dotQuote(e.To), attrs)
+	}
+
+	fmt.Fprintln(w, "}")
+}
+
+func dotQuote(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + r.Replace(s) + `"`
+}
+
+func writeMermaid(w io.Writer, g *graph, now time.Time) {
+	fmt.Fprintln(w, "---")
+	fmt.Fprintf(w, "title: %s\n", strconv.Quote(g.Title))
+	fmt.Fprintln(w, "---")
+	fmt.Fprintln(w, "flowchart LR")
+
+	classes := map[string][]string{}
+	node := func(indent string, t *asana.Task, external bool) {
+		lines := make([]string, 0, 2)
+		for _, l := range label(t) {
+			lines = append(lines, mermaidEscape(l))
+		}
+		fmt.Fprintf(w, "%st%s[\"%s\"]\n", indent, t.ID, strings.Join(lines, "<br/>"))
+
+		if s := status(t, now); s != statusOpen {
+			classes[s] = append(classes[s], "t"+t.ID)
+		}
+		if external {
+			classes["external"] = append(classes["external"], "t"+t.ID)
+		}
+	}
+
+	for _, c := range g.Clusters {
+		fmt.Fprintf(w, "  subgraph s%s[%s]\n", c.Section.ID, mermaidQuote(c.Section.Name))
+		for _, t := range c.Tasks {
+			node("    ", t, false)
+		}
+		fmt.Fprintln(w, "  end")
+	}
+	for _, t := range g.Loose {
+		node("  ", t, false)
+	}
+	for _, t := range g.External {
+		node("  ", t, true)
+	}
+
+	for _, e := range g.Edges {
+		arrow := "-->"
+		if e.Subtask {
+			arrow = "-.-"
+		}
+		fmt.Fprintf(w, "  t%s %s t%s\n", e.From, arrow, e.To)
+	}
+
+	fmt.Fprintln(w, "  classDef completed fill:#d4edda,stroke:#28a745,color:#555555")
+	fmt.Fprintln(w, "  classDef overdue fill:#f8d7da,stroke:#dc3545")
+	fmt.Fprintln(w, "  classDef external stroke-dasharray:5 5")
+	for _, name := range []string{statusCompleted, statusOverdue, "external"} {
+		if ids := classes[name]; len(ids) > 0 {
+			fmt.Fprintf(w, "  class %s %s\n", strings.Join(ids, ","), name)
+		}
+	}
+}
+
+func mermaidQuote(s string) string {
+	return `"` + mermaidEscape(s) + `"`
+}
+
+// mermaidEscape replaces the characters that end or break a quoted Mermaid
+// label with entity codes.
+func mermaidEscape(s string) string {
+	r := strings.NewReplacer(`"`, "#quot;", "<", "#lt;", ">", "#gt;", "\n", " ")
+	return r.Replace(s)
+}
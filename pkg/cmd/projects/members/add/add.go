@@ -0,0 +1,123 @@
+package add
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/projects/members/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type AddOptions struct {
+	cmdutils.BaseOptions
+
+	Project string
+	Members []string
+	Level   asana.AccessLevel
+}
+
+func NewCmdAdd(f factory.Factory, runF func(*AddOptions) error) *cobra.Command {
+	opts := &AddOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	var level string
+
+	cmd := &cobra.Command{
+		Use:   "add <project> <member>...",
+		Short: "Add users or teams to a project",
+		Long: heredoc.Doc(`
+				Add members to a project. A member is a user, given by name, email, ID or
+				"me", or a team, given by name or ID.
+
+				Members that already belong to the project keep their access level; use
+				set-level to change it.
+			`),
+		Example: heredoc.Doc(`
+				$ asana projects members add "Website" jane@example.com
+				$ asana projects members add "Website" Design "John Smith" --level commenter
+			`),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Project = args[0]
+			opts.Members = args[1:]
+
+			var err error
+			if opts.Level, err = shared.ParseLevel("level", level); err != nil {
+				return err
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runAdd(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&level, "level", "l", string(asana.AccessLevelEditor), "Access level: admin, editor, commenter or viewer")
+
+	return cmd
+}
+
+func runAdd(opts *AddOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	project, err := cmdutils.ResolveProject(client, cfg, opts.Prompter, opts.Project)
+	if err != nil {
+		return err
+	}
+
+	members := make([]*asana.ProjectMember, 0, len(opts.Members))
+	for _, name := range opts.Members {
+		member, err := shared.ResolveMember(client, cfg, name)
+		if err != nil {
+			return err
+		}
+		members = append(members, member)
+	}
+
+	memberships, err := project.AllMemberships(client, &asana.Options{Fields: shared.MembershipFields})
+	if err != nil {
+		return fmt.Errorf("failed to fetch members of project %q: %w", project.Name, err)
+	}
+
+	for _, member := range members {
+		if existing := shared.FindMembership(memberships, member); existing != nil {
+			opts.IO.Printf("%s %s is already a member of %s with %s access\n",
+				cs.WarningIcon, cs.Bold(member.Name), project.Name, existing.AccessLevel)
+			continue
+		}
+
+		level := opts.Level
+		_, err := project.CreateMembership(client, asana.CreateMembershipRequest{
+			MemberID:    member.ID,
+			AccessLevel: &level,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add %s to project: %w", member.Name, err)
+		}
+
+		opts.IO.Printf("%s Added %s to %s as %s\n", cs.SuccessIcon, cs.Bold(member.Name), cs.Bold(project.Name), level)
+	}
+
+	return nil
+}
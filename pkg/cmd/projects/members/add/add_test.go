@@ -0,0 +1,69 @@
+package add
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+func TestNewCmdAdd(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		wantMembers []string
+		wantLevel   asana.AccessLevel
+		wantErr     string
+	}{
+		{
+			name:        "default level",
+			args:        []string{"Website", "jane@example.com", "Design"},
+			wantMembers: []string{"jane@example.com", "Design"},
+			wantLevel:   asana.AccessLevelEditor,
+		},
+		{
+			name:        "viewer",
+			args:        []string{"Website", "me", "--level", "viewer"},
+			wantMembers: []string{"me"},
+			wantLevel:   asana.AccessLevelViewer,
+		},
+		{
+			name:    "invalid level",
+			args:    []string{"Website", "me", "-l", "owner"},
+			wantErr: `invalid value "owner" for flag --level`,
+		},
+		{
+			name:    "no members",
+			args:    []string{"Website"},
+			wantErr: "requires at least 2 arg(s), only received 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, _, _ := factory.NewTestFactory()
+
+			var got *AddOptions
+			cmd := NewCmdAdd(f, func(opts *AddOptions) error {
+				got = opts
+				return nil
+			})
+			cmd.SetArgs(tt.args)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "Website", got.Project)
+			assert.Equal(t, tt.wantMembers, got.Members)
+			assert.Equal(t, tt.wantLevel, got.Level)
+		})
+	}
+}
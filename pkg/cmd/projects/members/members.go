@@ -0,0 +1,130 @@
+package members
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/projects/members/add"
+	"github.com/timwehrle/asana/pkg/cmd/projects/members/remove"
+	"github.com/timwehrle/asana/pkg/cmd/projects/members/setlevel"
+	"github.com/timwehrle/asana/pkg/cmd/projects/members/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type MembersOptions struct {
+	cmdutils.BaseOptions
+
+	Project string
+	JSON    bool
+}
+
+func NewCmdMembers(f factory.Factory, runF func(*MembersOptions) error) *cobra.Command {
+	opts := &MembersOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "members [<project>]",
+		Short: "List and manage the members of a project",
+		Long: heredoc.Docf(`
+				List the users and teams that are members of a project with their access
+				level: %[1]s.
+
+				Use the subcommands to add and remove members or change their access level.
+			`, strings.Join(shared.Levels, ", ")),
+		Example: heredoc.Doc(`
+				$ asana projects members "Website"
+				$ asana projects members add "Website" jane@example.com Design --level editor
+				$ asana projects members set-level "Website" jane@example.com admin
+				$ asana projects members remove "Website" Design
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Project = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runMembers(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.JSON, "json", false, "Output members as JSON")
+
+	cmd.AddCommand(add.NewCmdAdd(f, nil))
+	cmd.AddCommand(remove.NewCmdRemove(f, nil))
+	cmd.AddCommand(setlevel.NewCmdSetLevel(f, nil))
+
+	return cmd
+}
+
+func runMembers(opts *MembersOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	project, err := cmdutils.ResolveProject(client, cfg, opts.Prompter, opts.Project)
+	if err != nil {
+		return err
+	}
+
+	memberships, err := project.AllMemberships(client, &asana.Options{Fields: shared.MembershipFields})
+	if err != nil {
+		return fmt.Errorf("failed to fetch members of project %q: %w", project.Name, err)
+	}
+
+	if opts.JSON {
+		enc := json.NewEncoder(opts.IO.Out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(memberships)
+	}
+
+	opts.IO.Printf("\nMembers of %s:\n\n", cs.Bold(project.Name))
+	if len(memberships) == 0 {
+		opts.IO.Println("No members found")
+		return nil
+	}
+
+	nameWidth := 0
+	for _, m := range memberships {
+		if m.Member != nil {
+			nameWidth = max(nameWidth, utf8.RuneCountInString(m.Member.Name))
+		}
+	}
+
+	for i, m := range memberships {
+		if m.Member == nil {
+			continue
+		}
+		padding := strings.Repeat(" ", nameWidth-utf8.RuneCountInString(m.Member.Name))
+		kind := ""
+		if m.Member.ResourceType == "team" {
+			kind = " " + cs.Gray("(team)")
+		}
+		opts.IO.Printf("%d. %s%s  %-9s%s\n", i+1, cs.Bold(m.Member.Name), padding, m.AccessLevel, kind)
+	}
+
+	return nil
+}
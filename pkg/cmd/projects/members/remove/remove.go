@@ -0,0 +1,129 @@
+package remove
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/projects/members/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type RemoveOptions struct {
+	cmdutils.BaseOptions
+
+	Project string
+	Members []string
+	Yes     bool
+	Force   bool
+}
+
+func NewCmdRemove(f factory.Factory, runF func(*RemoveOptions) error) *cobra.Command {
+	opts := &RemoveOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "remove <project> <member>...",
+		Short: "Remove users or teams from a project",
+		Long: heredoc.Doc(`
+				Remove members from a project. Members are given like for add.
+
+				Removing the last admin of the project is refused unless --force is given.
+			`),
+		Example: heredoc.Doc(`
+				$ asana projects members remove "Website" jane@example.com
+				$ asana projects members remove "Website" Design --yes
+			`),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Project = args[0]
+			opts.Members = args[1:]
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runRemove(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Skip the confirmation prompt")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Remove the last admin of the project")
+
+	return cmd
+}
+
+func runRemove(opts *RemoveOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	project, err := cmdutils.ResolveProject(client, cfg, opts.Prompter, opts.Project)
+	if err != nil {
+		return err
+	}
+
+	memberships, err := project.AllMemberships(client, &asana.Options{Fields: shared.MembershipFields})
+	if err != nil {
+		return fmt.Errorf("failed to fetch members of project %q: %w", project.Name, err)
+	}
+
+	removed := make([]*asana.ProjectMembership, 0, len(opts.Members))
+	for _, name := range opts.Members {
+		member, err := shared.ResolveMember(client, cfg, name)
+		if err != nil {
+			return err
+		}
+		membership := shared.FindMembership(memberships, member)
+		if membership == nil {
+			return fmt.Errorf("%s is not a member of %s", member.Name, project.Name)
+		}
+		removed = append(removed, membership)
+	}
+
+	if shared.LosesLastAdmin(memberships, removed) {
+		if !opts.Force {
+			return fmt.Errorf("this removes the last admin of %s; use --force to remove anyway", project.Name)
+		}
+		opts.IO.ErrPrintf("%s This removes the last admin of %s\n", cs.WarningIcon, project.Name)
+	}
+
+	if !opts.Yes {
+		message := fmt.Sprintf("Remove %d members from %s?", len(removed), project.Name)
+		if len(removed) == 1 {
+			message = fmt.Sprintf("Remove %s from %s?", removed[0].Member.Name, project.Name)
+		}
+		confirmed, err := opts.Prompter.Confirm(message, "No")
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return nil
+		}
+	}
+
+	for _, m := range removed {
+		if err := m.Delete(client); err != nil {
+			return fmt.Errorf("failed to remove %s from project: %w", m.Member.Name, err)
+		}
+		opts.IO.Printf("%s Removed %s from %s\n", cs.SuccessIcon, cs.Bold(m.Member.Name), cs.Bold(project.Name))
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package remove
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+func TestNewCmdRemove(t *testing.T) {
+	f, _, _ := factory.NewTestFactory()
+
+	var got *RemoveOptions
+	cmd := NewCmdRemove(f, func(opts *RemoveOptions) error {
+		got = opts
+		return nil
+	})
+	cmd.SetArgs([]string{"Website", "jane@example.com", "Design", "--yes", "--force"})
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "Website", got.Project)
+	assert.Equal(t, []string{"jane@example.com", "Design"}, got.Members)
+	assert.True(t, got.Yes)
+	assert.True(t, got.Force)
+}
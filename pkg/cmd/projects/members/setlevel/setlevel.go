@@ -0,0 +1,121 @@
+package setlevel
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/projects/members/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type SetLevelOptions struct {
+	cmdutils.BaseOptions
+
+	Project string
+	Member  string
+	Level   asana.AccessLevel
+	Force   bool
+}
+
+func NewCmdSetLevel(f factory.Factory, runF func(*SetLevelOptions) error) *cobra.Command {
+	opts := &SetLevelOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "set-level <project> <member> <level>",
+		Short: "Change the access level of a project member",
+		Long: heredoc.Doc(`
+				Change the access level of a user or team in a project to admin, editor,
+				commenter or viewer.
+
+				Taking admin access from the last admin of the project is refused unless
+				--force is given.
+			`),
+		Example: heredoc.Doc(`
+				$ asana projects members set-level "Website" jane@example.com admin
+				$ asana projects members set-level "Website" Design viewer
+			`),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Project = args[0]
+			opts.Member = args[1]
+
+			var err error
+			if opts.Level, err = shared.ParseLevel("level", args[2]); err != nil {
+				return err
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runSetLevel(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Change the access level of the last admin of the project")
+
+	return cmd
+}
+
+func runSetLevel(opts *SetLevelOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	project, err := cmdutils.ResolveProject(client, cfg, opts.Prompter, opts.Project)
+	if err != nil {
+		return err
+	}
+
+	member, err := shared.ResolveMember(client, cfg, opts.Member)
+	if err != nil {
+		return err
+	}
+
+	memberships, err := project.AllMemberships(client, &asana.Options{Fields: shared.MembershipFields})
+	if err != nil {
+		return fmt.Errorf("failed to fetch members of project %q: %w", project.Name, err)
+	}
+
+	membership := shared.FindMembership(memberships, member)
+	if membership == nil {
+		return fmt.Errorf("%s is not a member of %s", member.Name, project.Name)
+	}
+	if membership.AccessLevel == opts.Level {
+		opts.IO.Printf("%s %s already has %s access to %s\n", cs.WarningIcon, cs.Bold(member.Name), opts.Level, project.Name)
+		return nil
+	}
+
+	demoted := opts.Level != asana.AccessLevelAdmin
+	if demoted && shared.LosesLastAdmin(memberships, []*asana.ProjectMembership{membership}) {
+		if !opts.Force {
+			return fmt.Errorf("%s is the last admin of %s; use --force to change the access level anyway", member.Name, project.Name)
+		}
+		opts.IO.ErrPrintf("%s %s is the last admin of %s\n", cs.WarningIcon, member.Name, project.Name)
+	}
+
+	if err := membership.Update(client, opts.Level); err != nil {
+		return fmt.Errorf("failed to change access level: %w", err)
+	}
+
+	opts.IO.Printf("%s %s now has %s access to %s\n", cs.SuccessIcon, cs.Bold(member.Name), opts.Level, cs.Bold(project.Name))
+	return nil
+}
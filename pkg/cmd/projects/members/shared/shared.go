@@ -0,0 +1,90 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/internal/config"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+)
+
+// Levels are the access levels a project member can have, from most to least
+// privileged.
+var Levels = []string{
+	string(asana.AccessLevelAdmin),
+	string(asana.AccessLevelEditor),
+	string(asana.AccessLevelCommenter),
+	string(asana.AccessLevelViewer),
+}
+
+// ParseLevel validates an access level given as the value of flag.
+func ParseLevel(flag, level string) (asana.AccessLevel, error) {
+	level = strings.ToLower(level)
+	if err := cmdutils.ValidateStringEnum(flag, level, Levels); err != nil {
+		return "", err
+	}
+	return asana.AccessLevel(level), nil
+}
+
+// MembershipFields are the membership fields used by the members commands.
+var MembershipFields = []string{"member.name", "member.resource_type", "access_level"}
+
+// ResolveMember returns the user or team identified by nameOrID. Users are
+// matched first, by "me", GID, name or email, and teams by GID or name.
+func ResolveMember(client *asana.Client, cfg *config.Config, nameOrID string) (*asana.ProjectMember, error) {
+	user, err := cmdutils.ResolveUser(client, cfg, nameOrID)
+	if err == nil {
+		return &asana.ProjectMember{ID: user.ID, ResourceType: "user", Name: user.Name}, nil
+	}
+	if !errors.Is(err, cmdutils.ErrNotFound) {
+		return nil, err
+	}
+
+	// Only organizations have teams, so a failure to list them is not an error
+	ws := &asana.Workspace{ID: cfg.Workspace.ID}
+	teams, err := ws.AllTeams(client)
+	if err == nil {
+		for _, team := range teams {
+			if team.ID == nameOrID || strings.EqualFold(team.Name, nameOrID) {
+				return &asana.ProjectMember{ID: team.ID, ResourceType: "team", Name: team.Name}, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("no user or team %q found in workspace", nameOrID)
+}
+
+// FindMembership returns the membership of member in memberships, or nil.
+func FindMembership(memberships []*asana.ProjectMembership, member *asana.ProjectMember) *asana.ProjectMembership {
+	for _, m := range memberships {
+		if m.Member != nil && m.Member.ID == member.ID {
+			return m
+		}
+	}
+	return nil
+}
+
+// LosesLastAdmin reports whether the project is left without an admin once
+// the admin access of the given memberships is removed.
+func LosesLastAdmin(memberships, changed []*asana.ProjectMembership) bool {
+	affected := make(map[string]bool, len(changed))
+	removesAdmin := false
+	for _, m := range changed {
+		affected[m.ID] = true
+		if m.AccessLevel == asana.AccessLevelAdmin {
+			removesAdmin = true
+		}
+	}
+	if !removesAdmin {
+		return false
+	}
+
+	for _, m := range memberships {
+		if m.AccessLevel == asana.AccessLevelAdmin && !affected[m.ID] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,118 @@
+package shared
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/internal/config"
+)
+
+func membership(id string, level asana.AccessLevel) *asana.ProjectMembership {
+	return &asana.ProjectMembership{
+		ID:          id,
+		Member:      &asana.ProjectMember{ID: "u" + id, Name: "User " + id},
+		AccessLevel: level,
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	level, err := ParseLevel("level", "Commenter")
+	require.NoError(t, err)
+	assert.Equal(t, asana.AccessLevelCommenter, level)
+
+	_, err = ParseLevel("level", "owner")
+	require.EqualError(t, err, `invalid value "owner" for flag --level; valid values are: admin, commenter, editor, viewer`)
+}
+
+func TestFindMembership(t *testing.T) {
+	memberships := []*asana.ProjectMembership{
+		membership("1", asana.AccessLevelAdmin),
+		membership("2", asana.AccessLevelEditor),
+	}
+
+	assert.Equal(t, memberships[1], FindMembership(memberships, &asana.ProjectMember{ID: "u2"}))
+	assert.Nil(t, FindMembership(memberships, &asana.ProjectMember{ID: "u3"}))
+}
+
+func TestLosesLastAdmin(t *testing.T) {
+	admin := membership("1", asana.AccessLevelAdmin)
+	otherAdmin := membership("2", asana.AccessLevelAdmin)
+	editor := membership("3", asana.AccessLevelEditor)
+
+	tests := []struct {
+		name        string
+		memberships []*asana.ProjectMembership
+		changed     []*asana.ProjectMembership
+		want        bool
+	}{
+		{
+			name:        "only admin",
+			memberships: []*asana.ProjectMembership{admin, editor},
+			changed:     []*asana.ProjectMembership{admin},
+			want:        true,
+		},
+		{
+			name:        "another admin remains",
+			memberships: []*asana.ProjectMembership{admin, otherAdmin, editor},
+			changed:     []*asana.ProjectMembership{admin},
+		},
+		{
+			name:        "all admins",
+			memberships: []*asana.ProjectMembership{admin, otherAdmin, editor},
+			changed:     []*asana.ProjectMembership{admin, otherAdmin},
+			want:        true,
+		},
+		{
+			name:        "no admin affected",
+			memberships: []*asana.ProjectMembership{admin, editor},
+			changed:     []*asana.ProjectMembership{editor},
+		},
+		{
+			name:        "project without admins",
+			memberships: []*asana.ProjectMembership{editor},
+			changed:     []*asana.ProjectMembership{editor},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, LosesLastAdmin(tt.memberships, tt.changed))
+		})
+	}
+}
+
+func TestResolveMember(t *testing.T) {
+	cfg := &config.Config{Workspace: &asana.Workspace{ID: "W1"}}
+
+	t.Run("team when no user matches", func(t *testing.T) {
+		defer gock.Off()
+		gock.New("https://app.asana.com").
+			Get("/api/1.0/users").
+			Reply(200).
+			JSON(map[string]any{"data": []map[string]any{{"gid": "100", "name": "Alice"}}})
+		gock.New("https://app.asana.com").
+			Get("/api/1.0/organizations/W1/teams").
+			Reply(200).
+			JSON(map[string]any{"data": []map[string]any{{"gid": "7", "name": "Design"}}})
+
+		member, err := ResolveMember(asana.NewClient(http.DefaultClient), cfg, "design")
+		require.NoError(t, err)
+		assert.Equal(t, &asana.ProjectMember{ID: "7", ResourceType: "team", Name: "Design"}, member)
+	})
+
+	t.Run("user lookup failure", func(t *testing.T) {
+		defer gock.Off()
+		gock.New("https://app.asana.com").
+			Get("/api/1.0/users").
+			Reply(500).
+			JSON(map[string]any{"errors": []map[string]any{{"message": "Server Error"}}})
+
+		_, err := ResolveMember(asana.NewClient(http.DefaultClient), cfg, "design")
+		require.ErrorContains(t, err, "cannot fetch users")
+		assert.True(t, gock.IsDone())
+	})
+}
@@ -2,8 +2,18 @@ package projects
 
 import (
 	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/pkg/cmd/projects/archive"
+	"github.com/timwehrle/asana/pkg/cmd/projects/create"
+	"github.com/timwehrle/asana/pkg/cmd/projects/delete"
+	"github.com/timwehrle/asana/pkg/cmd/projects/duplicate"
+	"github.com/timwehrle/asana/pkg/cmd/projects/edit"
+	"github.com/timwehrle/asana/pkg/cmd/projects/graph"
 	"github.com/timwehrle/asana/pkg/cmd/projects/list"
+	"github.com/timwehrle/asana/pkg/cmd/projects/members"
+	"github.com/timwehrle/asana/pkg/cmd/projects/status"
 	"github.com/timwehrle/asana/pkg/cmd/projects/tasks"
+	"github.com/timwehrle/asana/pkg/cmd/projects/timeline"
+	"github.com/timwehrle/asana/pkg/cmd/projects/view"
 	"github.com/timwehrle/asana/pkg/factory"
 )
 
@@ -15,7 +25,18 @@ func NewCmdProjects(f factory.Factory) *cobra.Command {
 	}
 
 	cmd.AddCommand(list.NewCmdList(f, nil))
+	cmd.AddCommand(view.NewCmdView(f, nil))
 	cmd.AddCommand(tasks.NewCmdTasks(f, nil))
+	cmd.AddCommand(members.NewCmdMembers(f, nil))
+	cmd.AddCommand(status.NewCmdStatus(f))
+	cmd.AddCommand(graph.NewCmdGraph(f, nil))
+	cmd.AddCommand(timeline.NewCmdTimeline(f, nil))
+	cmd.AddCommand(create.NewCmdCreate(f, nil))
+	cmd.AddCommand(edit.NewCmdEdit(f, nil))
+	cmd.AddCommand(archive.NewCmdArchive(f, nil))
+	cmd.AddCommand(archive.NewCmdUnarchive(f, nil))
+	cmd.AddCommand(delete.NewCmdDelete(f, nil))
+	cmd.AddCommand(duplicate.NewCmdDuplicate(f, nil))
 
 	return cmd
 }
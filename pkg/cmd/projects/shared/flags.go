@@ -0,0 +1,139 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/convert"
+)
+
+// Colors are the colors a project can have.
+var Colors = []string{
+	"none",
+	"dark-pink", "dark-green", "dark-blue", "dark-red", "dark-teal", "dark-brown",
+	"dark-orange", "dark-purple", "dark-warm-gray",
+	"light-pink", "light-green", "light-blue", "light-red", "light-teal",
+	"light-yellow", "light-orange", "light-purple", "light-warm-gray",
+}
+
+var views = []string{
+	string(asana.ViewList), string(asana.ViewBoard), string(asana.ViewCalendar), string(asana.ViewTimeline),
+}
+
+// privacySettings maps the accepted --privacy values to the API values.
+var privacySettings = map[string]asana.PrivacySetting{
+	"workspace":           asana.PrivacySettingWorkspace,
+	"team":                asana.PrivacySettingTeam,
+	"private":             asana.PrivacySettingPrivate,
+	"public_to_workspace": asana.PrivacySettingWorkspace,
+	"private_to_team":     asana.PrivacySettingTeam,
+}
+
+// ProjectFlags are the flags setting the fields of a project, shared by the
+// create and edit commands.
+type ProjectFlags struct {
+	Name    string
+	Notes   string
+	Color   string
+	View    string
+	Privacy string
+	Start   string
+	Due     string
+	Icon    string
+	Owner   string
+}
+
+// Register adds the flags to cmd.
+func (pf *ProjectFlags) Register(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&pf.Name, "name", "n", "", "Project name")
+	cmd.Flags().StringVarP(&pf.Notes, "notes", "m", "", "Project description")
+	cmd.Flags().StringVar(&pf.Color, "color", "", "Project color, such as dark-blue or light-green")
+	cmd.Flags().StringVar(&pf.View, "view", "", "Default view: "+strings.Join(views, ", "))
+	cmd.Flags().StringVar(&pf.Privacy, "privacy", "", "Who can see the project: workspace, team or private")
+	cmd.Flags().StringVar(&pf.Start, "start", "", "Start date (YYYY-MM-DD, 'today', 'tomorrow', 'none')")
+	cmd.Flags().StringVar(&pf.Due, "due", "", "Due date (YYYY-MM-DD, 'today', 'tomorrow', 'none')")
+	cmd.Flags().StringVar(&pf.Icon, "icon", "", "Project icon, such as board, rocket or target")
+	cmd.Flags().StringVar(&pf.Owner, "owner", "", "Owner name, email or 'me'")
+}
+
+// Base returns the project fields of the flags that were set on cmd.
+func (pf *ProjectFlags) Base(cmd *cobra.Command, now time.Time) (asana.ProjectBase, error) {
+	base := asana.ProjectBase{}
+	changed := cmd.Flags().Changed
+
+	if changed("name") {
+		if strings.TrimSpace(pf.Name) == "" {
+			return base, fmt.Errorf("project name cannot be empty")
+		}
+		base.Name = strings.TrimSpace(pf.Name)
+	}
+	if changed("notes") {
+		base.Notes = pf.Notes
+	}
+	if changed("color") {
+		if err := cmdutils.ValidateStringEnum("color", pf.Color, Colors); err != nil {
+			return base, err
+		}
+		base.Color = pf.Color
+	}
+	if changed("view") {
+		if err := cmdutils.ValidateStringEnum("view", pf.View, views); err != nil {
+			return base, err
+		}
+		base.DefaultView = asana.View(pf.View)
+	}
+	if changed("privacy") {
+		privacy, ok := privacySettings[pf.Privacy]
+		if !ok {
+			return base, fmt.Errorf("invalid value %q for flag --privacy; valid values are: private, team, workspace", pf.Privacy)
+		}
+		base.PrivacySetting = privacy
+	}
+	if changed("icon") {
+		base.Icon = pf.Icon
+	}
+
+	var err error
+	if changed("start") && !isNone(pf.Start) {
+		if base.StartOn, err = convert.ToDueDate(pf.Start, now); err != nil {
+			return base, fmt.Errorf("invalid date for --start: %q (must be YYYY-MM-DD)", pf.Start)
+		}
+	}
+	if changed("due") && !isNone(pf.Due) {
+		if base.DueOn, err = convert.ToDueDate(pf.Due, now); err != nil {
+			return base, fmt.Errorf("invalid date for --due: %q (must be YYYY-MM-DD)", pf.Due)
+		}
+	}
+	if base.StartOn != nil && base.DueOn != nil && time.Time(*base.DueOn).Before(time.Time(*base.StartOn)) {
+		return base, fmt.Errorf("due date cannot be before the start date")
+	}
+
+	return base, nil
+}
+
+// Clear returns the API names of the fields that the flags set on cmd remove:
+// the notes when --notes is empty and the dates given as "none".
+func (pf *ProjectFlags) Clear(cmd *cobra.Command) []string {
+	var clear []string
+	changed := cmd.Flags().Changed
+
+	if changed("notes") && pf.Notes == "" {
+		clear = append(clear, "notes")
+	}
+	if changed("start") && isNone(pf.Start) {
+		clear = append(clear, "start_on")
+	}
+	if changed("due") && isNone(pf.Due) {
+		clear = append(clear, "due_on")
+	}
+
+	return clear
+}
+
+func isNone(value string) bool {
+	return strings.EqualFold(strings.TrimSpace(value), "none")
+}
@@ -0,0 +1,141 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/projects/status/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+	"github.com/timwehrle/asana/pkg/format"
+)
+
+type ListOptions struct {
+	cmdutils.BaseOptions
+
+	Project string
+	Limit   int
+	JSON    bool
+}
+
+func NewCmdList(f factory.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list [<project>]",
+		Short: "List the status updates of a project",
+		Long: heredoc.Doc(`
+				List the status updates of a project, newest first.
+			`),
+		Example: heredoc.Doc(`
+				$ asana projects status list "Website"
+				$ asana projects status list "Website" --limit 4 --json
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Project = args[0]
+			}
+			if opts.Limit < 0 {
+				return fmt.Errorf("invalid limit: %d", opts.Limit)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runList(opts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "l", 10, "Maximum number of updates to list, 0 for all")
+	cmd.Flags().BoolVar(&opts.JSON, "json", false, "Output status updates as JSON")
+
+	return cmd
+}
+
+func runList(opts *ListOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	project, err := cmdutils.ResolveProject(client, cfg, opts.Prompter, opts.Project)
+	if err != nil {
+		return err
+	}
+
+	updates, err := statusUpdates(client, project, opts.Limit)
+	if err != nil {
+		return err
+	}
+
+	if opts.JSON {
+		enc := json.NewEncoder(opts.IO.Out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(updates)
+	}
+
+	opts.IO.Printf("\nStatus updates of %s:\n\n", cs.Bold(project.Name))
+	if len(updates) == 0 {
+		opts.IO.Println("No status updates found")
+		return nil
+	}
+
+	for _, u := range updates {
+		date := ""
+		if u.CreatedAt != nil {
+			date = format.HumanDate(u.CreatedAt.Local())
+		}
+		author := ""
+		if u.Author != nil {
+			author = "by " + u.Author.Name
+		}
+		opts.IO.Printf("%-12s %s %s %s %s\n", date, shared.Badge(opts.IO, u.StatusType),
+			cs.Bold(u.Title), cs.Gray(author), cs.Gray(u.ID))
+	}
+
+	return nil
+}
+
+func statusUpdates(client *asana.Client, project *asana.Project, limit int) ([]*asana.StatusUpdate, error) {
+	var updates []*asana.StatusUpdate
+	options := &asana.Options{Limit: 100, Fields: shared.StatusFields}
+	if limit > 0 && limit < options.Limit {
+		options.Limit = limit
+	}
+
+	for {
+		batch, nextPage, err := project.StatusUpdates(client, options)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch status updates: %w", err)
+		}
+
+		updates = append(updates, batch...)
+
+		if limit > 0 && len(updates) >= limit {
+			return updates[:limit], nil
+		}
+		if nextPage == nil || nextPage.Offset == "" {
+			return updates, nil
+		}
+		options.Offset = nextPage.Offset
+	}
+}
@@ -0,0 +1,155 @@
+package post
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/projects/status/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type PostOptions struct {
+	cmdutils.BaseOptions
+
+	Project  string
+	Type     asana.StatusType
+	Title    string
+	Body     string
+	Markdown *bool
+}
+
+func NewCmdPost(f factory.Factory, runF func(*PostOptions) error) *cobra.Command {
+	opts := &PostOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	var (
+		statusType  string
+		useMarkdown bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "post [<project>]",
+		Short: "Post a status update on a project",
+		Long: heredoc.Docf(`
+				Post a status update on a project. Its followers are notified.
+
+				The type is one of %[2]s, and is asked for when not
+				given. The body is taken from --body, read from standard input, or written
+				in your editor. With --markdown it is written in Markdown; set
+				%[1]sasana config set markdown%[1]s to make this the default.
+			`, "`", strings.Join(shared.Types, ", ")),
+		Example: heredoc.Doc(`
+				$ asana projects status post "Website" --type at-risk --title "Week 12"
+				$ asana projects status post "Website" -t on-track --title "Week 13" --body "Launch is on schedule"
+				$ ./weekly-report.sh | asana projects status post "Website" -t on-track --title "Week 14" --body -
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Project = args[0]
+			}
+			if cmd.Flags().Changed("markdown") {
+				opts.Markdown = &useMarkdown
+			}
+
+			if statusType != "" {
+				var err error
+				if opts.Type, err = shared.ParseType(statusType); err != nil {
+					return err
+				}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runPost(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&statusType, "type", "t", "", "Status: "+strings.Join(shared.Types, ", "))
+	cmd.Flags().StringVar(&opts.Title, "title", "", "Title of the update")
+	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", `Text of the update, or "-" to read from standard input`)
+	cmd.Flags().BoolVar(&useMarkdown, "markdown", false, "Write the body in Markdown")
+
+	return cmd
+}
+
+func runPost(opts *PostOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	project, err := cmdutils.ResolveProject(client, cfg, opts.Prompter, opts.Project)
+	if err != nil {
+		return err
+	}
+
+	if opts.Type == "" {
+		names := make([]string, len(shared.Types))
+		for i, t := range shared.Types {
+			parsed, _ := shared.ParseType(t)
+			names[i] = shared.TypeName(parsed)
+		}
+		index, err := opts.Prompter.Select("Select status: ", names)
+		if err != nil {
+			return fmt.Errorf("status selection failed: %w", err)
+		}
+		opts.Type, _ = shared.ParseType(shared.Types[index])
+	}
+
+	title := strings.TrimSpace(opts.Title)
+	if title == "" {
+		if title, err = opts.Prompter.Input("Enter title: ", ""); err != nil {
+			return fmt.Errorf("failed to read title: %w", err)
+		}
+		if title = strings.TrimSpace(title); title == "" {
+			return errors.New("title cannot be empty")
+		}
+	}
+
+	body, err := cmdutils.ReadBody(&opts.BaseOptions, opts.Body, "Write your status update:", "")
+	if err != nil {
+		return err
+	}
+
+	htmlText, err := cmdutils.CommentHTML(client, cfg, body, cmdutils.UseMarkdown(opts.Markdown, cfg))
+	if err != nil {
+		return fmt.Errorf("failed to convert status update: %w", err)
+	}
+
+	update, err := client.CreateStatusUpdate(&asana.CreateStatusUpdateRequest{
+		StatusUpdateBase: asana.StatusUpdateBase{
+			Title:      title,
+			HTMLText:   htmlText,
+			StatusType: opts.Type,
+		},
+		Parent: project.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to post status update: %w", err)
+	}
+
+	opts.IO.Printf("%s Posted %s on %s: %s %s\n", cs.SuccessIcon, shared.Badge(opts.IO, opts.Type),
+		cs.Bold(project.Name), update.Title, cs.Gray(update.ID))
+	return nil
+}
@@ -0,0 +1,64 @@
+package post
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+func TestNewCmdPost(t *testing.T) {
+	yes := true
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    PostOptions
+		wantErr string
+	}{
+		{
+			name: "flags",
+			args: []string{"Website", "--type", "at-risk", "--title", "Week 12", "--body", "-"},
+			want: PostOptions{Project: "Website", Type: asana.StatusAtRisk, Title: "Week 12", Body: "-"},
+		},
+		{
+			name: "no type",
+			args: []string{"--title", "Week 12", "--markdown"},
+			want: PostOptions{Title: "Week 12", Markdown: &yes},
+		},
+		{
+			name:    "invalid type",
+			args:    []string{"-t", "green"},
+			wantErr: `invalid status type "green": expected one of on-track, at-risk, off-track, on-hold, complete`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, _, _ := factory.NewTestFactory()
+
+			var got *PostOptions
+			cmd := NewCmdPost(f, func(opts *PostOptions) error {
+				got = opts
+				return nil
+			})
+			cmd.SetArgs(tt.args)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.Project, got.Project)
+			assert.Equal(t, tt.want.Type, got.Type)
+			assert.Equal(t, tt.want.Title, got.Title)
+			assert.Equal(t, tt.want.Body, got.Body)
+			assert.Equal(t, tt.want.Markdown, got.Markdown)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/iostreams"
+)
+
+// StatusFields are the status update fields shown by the status commands.
+var StatusFields = []string{
+	"title", "text", "html_text", "status_type", "author.name", "created_at", "parent.name",
+}
+
+// Types are the status types of a project status update, as accepted on the
+// command line.
+var Types = []string{"on-track", "at-risk", "off-track", "on-hold", "complete"}
+
+var typeNames = map[asana.StatusType]string{
+	asana.StatusOnTrack:  "On track",
+	asana.StatusAtRisk:   "At risk",
+	asana.StatusOffTrack: "Off track",
+	asana.StatusOnHold:   "On hold",
+	asana.StatusComplete: "Complete",
+}
+
+// ParseType converts a status type such as at-risk or at_risk.
+func ParseType(s string) (asana.StatusType, error) {
+	t := asana.StatusType(strings.ReplaceAll(strings.ToLower(strings.TrimSpace(s)), "-", "_"))
+	if _, ok := typeNames[t]; !ok {
+		return "", fmt.Errorf("invalid status type %q: expected one of %s", s, strings.Join(Types, ", "))
+	}
+	return t, nil
+}
+
+// TypeName returns the display name of a status type.
+func TypeName(t asana.StatusType) string {
+	if name, ok := typeNames[t]; ok {
+		return name
+	}
+	return strings.ReplaceAll(string(t), "_", " ")
+}
+
+// Badge returns the colored display name of a status type.
+func Badge(ios *iostreams.IOStreams, t asana.StatusType) string {
+	cs := ios.ColorScheme()
+	name := TypeName(t)
+
+	switch t {
+	case asana.StatusOnTrack, asana.StatusComplete:
+		return ios.ColorFromScheme(name, cs.Success)
+	case asana.StatusAtRisk, asana.StatusOnHold:
+		return ios.ColorFromScheme(name, cs.Warning)
+	case asana.StatusOffTrack:
+		return ios.ColorFromScheme(name, cs.Error)
+	default:
+		return name
+	}
+}
@@ -0,0 +1,42 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/internal/api/asana"
+)
+
+func TestParseType(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    asana.StatusType
+		wantErr string
+	}{
+		{input: "on-track", want: asana.StatusOnTrack},
+		{input: "At-Risk", want: asana.StatusAtRisk},
+		{input: "off_track", want: asana.StatusOffTrack},
+		{input: " on-hold ", want: asana.StatusOnHold},
+		{input: "complete", want: asana.StatusComplete},
+		{input: "achieved", wantErr: `invalid status type "achieved": expected one of on-track, at-risk, off-track, on-hold, complete`},
+		{input: "", wantErr: `invalid status type "": expected one of on-track, at-risk, off-track, on-hold, complete`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseType(tt.input)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTypeName(t *testing.T) {
+	assert.Equal(t, "Off track", TypeName(asana.StatusOffTrack))
+	assert.Equal(t, "partial", TypeName(asana.StatusPartial))
+}
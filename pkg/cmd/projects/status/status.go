@@ -0,0 +1,23 @@
+package status
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/pkg/cmd/projects/status/list"
+	"github.com/timwehrle/asana/pkg/cmd/projects/status/post"
+	"github.com/timwehrle/asana/pkg/cmd/projects/status/view"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+func NewCmdStatus(f factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status <subcommand>",
+		Short: "Post and read project status updates",
+		Long:  "Post status updates on a project and browse its status history.",
+	}
+
+	cmd.AddCommand(post.NewCmdPost(f, nil))
+	cmd.AddCommand(list.NewCmdList(f, nil))
+	cmd.AddCommand(view.NewCmdView(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,141 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/projects/status/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+	"github.com/timwehrle/asana/pkg/format"
+)
+
+type ViewOptions struct {
+	cmdutils.BaseOptions
+
+	Project string
+	ID      string
+	JSON    bool
+}
+
+func NewCmdView(f factory.Factory, runF func(*ViewOptions) error) *cobra.Command {
+	opts := &ViewOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view [<project>]",
+		Short: "View a status update",
+		Long: heredoc.Doc(`
+				Show the latest status update of a project, or the update given by --id.
+			`),
+		Example: heredoc.Doc(`
+				$ asana projects status view "Website"
+				$ asana projects status view --id 1204567890123
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Project = args[0]
+			}
+			if opts.Project != "" && opts.ID != "" {
+				return fmt.Errorf("specify either a project or --id, not both")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runView(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.ID, "id", "", "ID of the status update")
+	cmd.Flags().BoolVar(&opts.JSON, "json", false, "Output the status update as JSON")
+
+	return cmd
+}
+
+func runView(opts *ViewOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	update, err := resolveUpdate(opts)
+	if err != nil {
+		return err
+	}
+
+	if opts.JSON {
+		enc := json.NewEncoder(opts.IO.Out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(update)
+	}
+
+	opts.IO.Printf("%s %s\n", shared.Badge(opts.IO, update.StatusType), cs.Bold(update.Title))
+
+	var meta []string
+	if update.Parent != nil && update.Parent.Name != "" {
+		meta = append(meta, update.Parent.Name)
+	}
+	if update.Author != nil {
+		meta = append(meta, "by "+update.Author.Name)
+	}
+	if update.CreatedAt != nil {
+		meta = append(meta, format.RelativeTime(*update.CreatedAt))
+	}
+	if len(meta) > 0 {
+		opts.IO.Println(cs.Gray(strings.Join(meta, " · ")))
+	}
+
+	if body := format.RichText(update.HTMLText, update.Text, opts.IO); body != "" {
+		opts.IO.Printf("\n%s\n", body)
+	}
+
+	return nil
+}
+
+func resolveUpdate(opts *ViewOptions) (*asana.StatusUpdate, error) {
+	cfg, err := opts.Config()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	if opts.ID != "" {
+		update := &asana.StatusUpdate{ID: opts.ID}
+		if err := update.Fetch(client, &asana.Options{Fields: shared.StatusFields}); err != nil {
+			if asana.IsNotFoundError(err) {
+				return nil, fmt.Errorf("status update %s not found", opts.ID)
+			}
+			return nil, fmt.Errorf("failed to fetch status update: %w", err)
+		}
+		return update, nil
+	}
+
+	project, err := cmdutils.ResolveProject(client, cfg, opts.Prompter, opts.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	updates, _, err := project.StatusUpdates(client, &asana.Options{Limit: 1, Fields: shared.StatusFields})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch status updates: %w", err)
+	}
+	if len(updates) == 0 {
+		return nil, fmt.Errorf("%s has no status updates", project.Name)
+	}
+
+	return updates[0], nil
+}
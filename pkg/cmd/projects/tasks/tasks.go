@@ -1,7 +1,6 @@
 package tasks
 
 import (
-	"errors"
 	"fmt"
 	"github.com/MakeNowJust/heredoc"
 
@@ -10,7 +9,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/timwehrle/asana/internal/api/asana"
-	"github.com/timwehrle/asana/pkg/cmd/projects/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
 	"github.com/timwehrle/asana/pkg/factory"
 	"github.com/timwehrle/asana/pkg/iostreams"
 )
@@ -23,11 +22,17 @@ type TasksOptions struct {
 	Client func() (*asana.Client, error)
 
 	WithSections bool
+	Tree         bool
+	Project      string
 }
 
+// subtaskTrees holds the subtasks of listed tasks by task ID when --tree is set.
+type subtaskTrees map[string][]*cmdutils.TaskNode
+
 type sectionTasks struct {
-	section *asana.Section
-	tasks   []*asana.Task
+	section  *asana.Section
+	tasks    []*asana.Task
+	subtasks subtaskTrees
 }
 
 func NewCmdTasks(f factory.Factory, runF func(*TasksOptions) error) *cobra.Command {
@@ -40,13 +45,23 @@ func NewCmdTasks(f factory.Factory, runF func(*TasksOptions) error) *cobra.Comma
 	cmd := &cobra.Command{
 		Use:   "tasks",
 		Short: "List tasks of a project",
-		Long:  "Retrieve and display a list of all tasks under a project.",
+		Long: heredoc.Doc(`
+					Retrieve and display a list of all tasks under a project.
+
+					Inside a linked directory the linked project is used instead of prompting.
+				`),
 		Example: heredoc.Doc(`
 					# List all tasks of a project
 					$ asana project tasks
 
 					# List tasks of a project with a specific section
 					$ asana project tasks --sections
+
+					# List tasks of a project by name
+					$ asana project tasks --project "Website"
+
+					# Nest subtasks beneath their parents
+					$ asana project tasks --tree
 				`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if runF != nil {
@@ -58,6 +73,8 @@ func NewCmdTasks(f factory.Factory, runF func(*TasksOptions) error) *cobra.Comma
 	}
 
 	cmd.Flags().BoolVarP(&opts.WithSections, "sections", "s", false, "Group tasks by sections")
+	cmd.Flags().BoolVar(&opts.Tree, "tree", false, "Nest subtasks beneath their parents")
+	cmd.Flags().StringVarP(&opts.Project, "project", "p", "", "Project name or ID (defaults to the linked project)")
 	return cmd
 }
 
@@ -72,7 +89,7 @@ func runTasks(opts *TasksOptions) error {
 		return err
 	}
 
-	project, err := selectProject(opts, client, cfg.Workspace.ID)
+	project, err := cmdutils.ResolveProject(client, cfg, opts.Prompter, opts.Project)
 	if err != nil {
 		return err
 	}
@@ -84,37 +101,9 @@ func runTasks(opts *TasksOptions) error {
 	return listAllTasks(opts, client, project)
 }
 
-func selectProject(
-	opts *TasksOptions,
-	client *asana.Client,
-	workspaceID string,
-) (*asana.Project, error) {
-	projects, err := shared.FetchAllProjects(client, &asana.Workspace{ID: workspaceID}, 0)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch projects: %w", err)
-	}
-
-	if len(projects) == 0 {
-		fmt.Fprintln(opts.IO.Out, "No projects found")
-		return nil, errors.New("no projects found")
-	}
-
-	projectNames := make([]string, len(projects))
-	for i, project := range projects {
-		projectNames[i] = project.Name
-	}
-
-	index, err := opts.Prompter.Select("Select a project:", projectNames)
-	if err != nil {
-		return nil, fmt.Errorf("failed to select a project: %w", err)
-	}
-
-	return projects[index], nil
-}
-
 func listAllTasks(opts *TasksOptions, client *asana.Client, project *asana.Project) error {
 	tasks := make([]*asana.Task, 0, 50)
-	options := &asana.Options{}
+	options := &asana.Options{Fields: taskFields(opts)}
 
 	for {
 		batch, nextPage, err := project.Tasks(client, options)
@@ -131,7 +120,15 @@ func listAllTasks(opts *TasksOptions, client *asana.Client, project *asana.Proje
 		options.Offset = nextPage.Offset
 	}
 
-	return displayTasks(opts, project, tasks)
+	var trees subtaskTrees
+	if opts.Tree {
+		var err error
+		if tasks, trees, err = withSubtasks(client, tasks); err != nil {
+			return err
+		}
+	}
+
+	return displayTasks(opts, project, tasks, trees)
 }
 
 func listTasksWithSections(opts *TasksOptions, client *asana.Client, project *asana.Project) error {
@@ -157,7 +154,7 @@ func listTasksWithSections(opts *TasksOptions, client *asana.Client, project *as
 
 	for _, section := range sections {
 		tasks := make([]*asana.Task, 0, 50)
-		options := &asana.Options{}
+		options := &asana.Options{Fields: taskFields(opts)}
 
 		for {
 			batch, nextPage, err := section.Tasks(client, options)
@@ -174,16 +171,25 @@ func listTasksWithSections(opts *TasksOptions, client *asana.Client, project *as
 			options.Offset = nextPage.Offset
 		}
 
+		var trees subtaskTrees
+		if opts.Tree {
+			var err error
+			if tasks, trees, err = withSubtasks(client, tasks); err != nil {
+				return err
+			}
+		}
+
 		sectionsWithTasks = append(sectionsWithTasks, sectionTasks{
-			section: section,
-			tasks:   tasks,
+			section:  section,
+			tasks:    tasks,
+			subtasks: trees,
 		})
 	}
 
 	return displayTasksBySection(opts, project, sectionsWithTasks)
 }
 
-func displayTasks(opts *TasksOptions, project *asana.Project, tasks []*asana.Task) error {
+func displayTasks(opts *TasksOptions, project *asana.Project, tasks []*asana.Task, trees subtaskTrees) error {
 	cs := opts.IO.ColorScheme()
 	out := opts.IO.Out
 
@@ -196,6 +202,7 @@ func displayTasks(opts *TasksOptions, project *asana.Project, tasks []*asana.Tas
 
 	for i, task := range tasks {
 		fmt.Fprintf(out, "%d. %s\n", i+1, cs.Bold(task.Name))
+		cmdutils.PrintTaskTree(opts.IO, trees[task.ID], "   ")
 	}
 
 	return nil
@@ -225,9 +232,46 @@ func displayTasksBySection(
 
 		for i, task := range st.tasks {
 			fmt.Fprintf(out, "  %d. %s\n", i+1, task.Name)
+			cmdutils.PrintTaskTree(opts.IO, st.subtasks[task.ID], "     ")
 		}
 		fmt.Fprintln(out)
 	}
 
 	return nil
 }
+
+func taskFields(opts *TasksOptions) []string {
+	if opts.Tree {
+		return []string{"name", "num_subtasks", "parent"}
+	}
+	return nil
+}
+
+// withSubtasks fetches the subtask trees of tasks and drops subtasks that are
+// listed in the project themselves, since they are shown beneath their parent.
+func withSubtasks(client *asana.Client, tasks []*asana.Task) ([]*asana.Task, subtaskTrees, error) {
+	listed := make(map[string]bool, len(tasks))
+	for _, task := range tasks {
+		listed[task.ID] = true
+	}
+
+	topLevel := make([]*asana.Task, 0, len(tasks))
+	trees := make(subtaskTrees)
+	for _, task := range tasks {
+		if task.Parent != nil && listed[task.Parent.ID] {
+			continue
+		}
+		topLevel = append(topLevel, task)
+
+		if task.NumSubtasks == 0 {
+			continue
+		}
+		tree, err := cmdutils.SubtaskTree(client, task, 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		trees[task.ID] = tree
+	}
+
+	return topLevel, trees, nil
+}
@@ -0,0 +1,210 @@
+package timeline
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/iostreams"
+)
+
+const (
+	barOpen      = "█"
+	barCompleted = "░"
+	barMilestone = "◆"
+)
+
+func completed(t *asana.Task) bool {
+	return t.Completed != nil && *t.Completed
+}
+
+// writeASCII draws a Gantt chart that fits into width columns.
+func writeASCII(ios *iostreams.IOStreams, tl *timeline, width int, now time.Time) {
+	cs := ios.ColorScheme()
+	out := ios.Out
+
+	fmt.Fprintf(out, "%s\n\n", cs.Bold(tl.Title))
+
+	if len(tl.Groups) == 0 {
+		fmt.Fprintln(out, "No tasks with dates")
+	} else {
+		first, last := tl.span()
+
+		labelWidth := 0
+		for _, g := range tl.Groups {
+			for _, it := range g.Items {
+				labelWidth = max(labelWidth, utf8.RuneCountInString(it.Task.Name)+2)
+			}
+		}
+		labelWidth = min(labelWidth, 32, width/3)
+		barWidth := max(width-labelWidth-2, 10)
+
+		days := int(last.Sub(first).Hours()/24) + 1
+		col := func(d time.Time) int {
+			return int(d.Sub(first).Hours()/24) * barWidth / days
+		}
+
+		from, to := first.Format("Jan 02, 2006"), last.Format("Jan 02, 2006")
+		axis := from
+		if gap := barWidth - len(from) - len(to); gap > 0 {
+			axis += strings.Repeat(" ", gap) + to
+		}
+		fmt.Fprintf(out, "%s  %s\n", strings.Repeat(" ", labelWidth), cs.Dim(axis))
+
+		for _, g := range tl.Groups {
+			fmt.Fprintln(out, cs.Bold(g.Name))
+
+			for _, it := range g.Items {
+				start := min(col(it.Start), barWidth-1)
+				var bar string
+				switch {
+				case it.Milestone:
+					bar = barMilestone
+				case completed(it.Task):
+					bar = strings.Repeat(barCompleted, max(col(it.End.AddDate(0, 0, 1))-start, 1))
+				default:
+					bar = strings.Repeat(barOpen, max(col(it.End.AddDate(0, 0, 1))-start, 1))
+				}
+				if !completed(it.Task) && cmdutils.IsOverdue(it.Task, now) {
+					bar = ios.ColorFromScheme(bar, cs.Error)
+				}
+
+				fmt.Fprintf(out, "  %s  %s%s\n", pad(it.Task.Name, labelWidth-2), strings.Repeat(" ", start), bar)
+			}
+		}
+
+		fmt.Fprintf(out, "\n%s open  %s completed  %s milestone  %s overdue\n",
+			barOpen, barCompleted, barMilestone, ios.ColorFromScheme(barOpen, cs.Error))
+	}
+
+	if len(tl.Undated) > 0 {
+		fmt.Fprintf(out, "\n%s\n", cs.Bold("Without dates"))
+		for _, g := range tl.Undated {
+			for _, it := range g.Items {
+				fmt.Fprintf(out, "  • %s %s\n", it.Task.Name, cs.Dim("("+g.Name+")"))
+			}
+		}
+	}
+}
+
+// pad truncates or pads s to exactly n runes.
+func pad(s string, n int) string {
+	l := utf8.RuneCountInString(s)
+	if l > n {
+		return string([]rune(s)[:max(n-1, 0)]) + "…"
+	}
+	return s + strings.Repeat(" ", n-l)
+}
+
+// writeMermaid prints a Mermaid Gantt chart. Dependencies on other tasks of
+// the chart start a task after them.
+func writeMermaid(w io.Writer, tl *timeline, now time.Time) {
+	fmt.Fprintln(w, "gantt")
+	fmt.Fprintf(w, "  title %s\n", mermaidText(tl.Title))
+	fmt.Fprintln(w, "  dateFormat YYYY-MM-DD")
+
+	charted := make(map[string]bool)
+	for _, g := range tl.Groups {
+		for _, it := range g.Items {
+			charted[it.Task.ID] = true
+		}
+	}
+
+	for _, g := range tl.Groups {
+		fmt.Fprintf(w, "  section %s\n", mermaidText(g.Name))
+
+		for _, it := range g.Items {
+			var tags []string
+			switch {
+			case completed(it.Task):
+				tags = append(tags, "done")
+			case cmdutils.IsOverdue(it.Task, now):
+				tags = append(tags, "crit")
+			}
+			if it.Milestone {
+				tags = append(tags, "milestone")
+			}
+			tags = append(tags, "t"+it.Task.ID)
+
+			start := it.Start.Format(time.DateOnly)
+			var after []string
+			for _, dep := range it.Task.Dependencies {
+				if charted[dep.ID] {
+					after = append(after, "t"+dep.ID)
+				}
+			}
+			if len(after) > 0 {
+				start = "after " + strings.Join(after, " ")
+			}
+
+			// Mermaid end dates are exclusive
+			end := it.End.AddDate(0, 0, 1).Format(time.DateOnly)
+			if it.Milestone {
+				end = "0d"
+			}
+
+			fmt.Fprintf(w, "    %s :%s, %s, %s\n", mermaidText(it.Task.Name), strings.Join(tags, ", "), start, end)
+		}
+	}
+
+	for _, g := range tl.Undated {
+		for _, it := range g.Items {
+			fmt.Fprintf(w, "  %%%% Without dates: %s (%s)\n", it.Task.Name, g.Name)
+		}
+	}
+}
+
+// mermaidText removes the characters that Mermaid reads as separators or
+// comments in a Gantt chart.
+func mermaidText(s string) string {
+	r := strings.NewReplacer(":", "", ";", "", "#", "", "\n", " ")
+	return strings.TrimSpace(r.Replace(s))
+}
+
+// writeCSV prints one row per task. Undated tasks have empty dates.
+func writeCSV(w io.Writer, tl *timeline) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"id", "name", "section", "type", "start", "end", "completed", "dependencies"})
+
+	row := func(g *group, it *item) {
+		kind := "task"
+		if it.Milestone || it.Task.ResourceSubtype == "milestone" {
+			kind = "milestone"
+		}
+
+		var start, end string
+		if !it.Start.IsZero() {
+			start = it.Start.Format(time.DateOnly)
+			end = it.End.Format(time.DateOnly)
+		}
+
+		deps := make([]string, 0, len(it.Task.Dependencies))
+		for _, dep := range it.Task.Dependencies {
+			deps = append(deps, dep.ID)
+		}
+
+		_ = cw.Write([]string{
+			it.Task.ID, it.Task.Name, g.Name, kind, start, end,
+			fmt.Sprint(completed(it.Task)), strings.Join(deps, ";"),
+		})
+	}
+
+	for _, g := range tl.Groups {
+		for _, it := range g.Items {
+			row(g, it)
+		}
+	}
+	for _, g := range tl.Undated {
+		for _, it := range g.Items {
+			row(g, it)
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
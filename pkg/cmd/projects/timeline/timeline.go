@@ -0,0 +1,242 @@
+package timeline
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type TimelineOptions struct {
+	cmdutils.BaseOptions
+
+	Project string
+	Format  string
+
+	now func() time.Time
+}
+
+var formats = []string{"ascii", "mermaid", "csv"}
+
+// taskFields are the task fields needed to lay out the timeline.
+var taskFields = []string{
+	"name", "resource_subtype", "completed", "start_on", "due_on", "due_at",
+	"dependencies", "memberships.project", "memberships.section",
+}
+
+// item is a task placed on the timeline. Start and End are whole days, and
+// End is inclusive.
+type item struct {
+	Task       *asana.Task
+	Start, End time.Time
+	Milestone  bool
+}
+
+// group holds the dated tasks of a section.
+type group struct {
+	Name  string
+	Items []*item
+}
+
+// timeline holds the tasks of a project laid out by date.
+type timeline struct {
+	Title  string
+	Groups []*group
+	// Undated holds the tasks without a start or due date. Their items have
+	// zero dates.
+	Undated []*group
+}
+
+func NewCmdTimeline(f factory.Factory, runF func(*TimelineOptions) error) *cobra.Command {
+	opts := &TimelineOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+		now: time.Now,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "timeline [<project>]",
+		Short: "Show the timeline of a project",
+		Long: heredoc.Doc(`
+				Lay out the tasks of a project by their start and due dates, grouped by
+				section. Milestones are marked, and tasks without dates are listed
+				separately.
+
+				The ascii format draws a Gantt chart scaled to the terminal width. The
+				mermaid format prints a Mermaid Gantt chart, in which dependencies become
+				"after" relations. The csv format prints one row per task.
+
+				Inside a linked directory the linked project is used instead of prompting.
+			`),
+		Example: heredoc.Doc(`
+				$ asana projects timeline "Website"
+				$ asana projects timeline --format mermaid > timeline.mmd
+				$ asana projects timeline --format csv > timeline.csv
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Project = args[0]
+			}
+			if !slices.Contains(formats, opts.Format) {
+				return fmt.Errorf("invalid format %q: expected ascii, mermaid or csv", opts.Format)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runTimeline(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Format, "format", "f", "ascii", "Output format: ascii, mermaid or csv")
+
+	return cmd
+}
+
+func runTimeline(opts *TimelineOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	project, err := cmdutils.ResolveProject(client, cfg, opts.Prompter, opts.Project)
+	if err != nil {
+		return err
+	}
+
+	sections, err := cmdutils.AllSections(client, project)
+	if err != nil {
+		return fmt.Errorf("failed to fetch sections: %w", err)
+	}
+
+	tasks, err := cmdutils.AllProjectTasks(client, project, taskFields)
+	if err != nil {
+		return fmt.Errorf("failed to fetch tasks for project %q: %w", project.Name, err)
+	}
+
+	tl := buildTimeline(project, sections, tasks)
+
+	switch opts.Format {
+	case "mermaid":
+		writeMermaid(opts.IO.Out, tl, opts.now())
+		return nil
+	case "csv":
+		return writeCSV(opts.IO.Out, tl)
+	default:
+		writeASCII(opts.IO, tl, opts.IO.TerminalWidth(), opts.now())
+		return nil
+	}
+}
+
+// buildTimeline groups the tasks by section, in project order. Dated and
+// undated tasks are grouped separately.
+func buildTimeline(project *asana.Project, sections []*asana.Section, tasks []*asana.Task) *timeline {
+	tl := &timeline{Title: project.Name}
+
+	names := make([]string, 0, len(sections)+1)
+	index := make(map[string]int, len(sections))
+	for i, s := range sections {
+		names = append(names, s.Name)
+		index[s.ID] = i
+	}
+	names = append(names, "No section")
+
+	dated := make([]*group, len(names))
+	undated := make([]*group, len(names))
+	for i, name := range names {
+		dated[i] = &group{Name: name}
+		undated[i] = &group{Name: name}
+	}
+
+	for _, t := range tasks {
+		i := len(names) - 1
+		for _, m := range t.Memberships {
+			if m.Project != nil && m.Project.ID == project.ID && m.Section != nil {
+				if si, ok := index[m.Section.ID]; ok {
+					i = si
+				}
+				break
+			}
+		}
+
+		if it := newItem(t); it != nil {
+			dated[i].Items = append(dated[i].Items, it)
+		} else {
+			undated[i].Items = append(undated[i].Items, &item{Task: t})
+		}
+	}
+
+	for i := range names {
+		if len(dated[i].Items) > 0 {
+			tl.Groups = append(tl.Groups, dated[i])
+		}
+		if len(undated[i].Items) > 0 {
+			tl.Undated = append(tl.Undated, undated[i])
+		}
+	}
+
+	return tl
+}
+
+// newItem places a task on the timeline, or returns nil if it has no dates.
+// A task with only one date spans that single day.
+func newItem(t *asana.Task) *item {
+	var start, end time.Time
+	switch {
+	case t.DueAt != nil:
+		due := t.DueAt.Local()
+		end = time.Date(due.Year(), due.Month(), due.Day(), 0, 0, 0, 0, time.UTC)
+	case t.DueOn != nil:
+		end = time.Time(*t.DueOn)
+	}
+	if t.StartOn != nil {
+		start = time.Time(*t.StartOn)
+	}
+
+	switch {
+	case start.IsZero() && end.IsZero():
+		return nil
+	case start.IsZero():
+		start = end
+	case end.IsZero():
+		end = start
+	}
+
+	milestone := t.ResourceSubtype == "milestone"
+	if milestone {
+		start = end
+	}
+
+	return &item{Task: t, Start: start, End: end, Milestone: milestone}
+}
+
+// span returns the first and last day covered by the timeline.
+func (tl *timeline) span() (first, last time.Time) {
+	for _, g := range tl.Groups {
+		for _, it := range g.Items {
+			if first.IsZero() || it.Start.Before(first) {
+				first = it.Start
+			}
+			if last.IsZero() || it.End.After(last) {
+				last = it.End
+			}
+		}
+	}
+	return first, last
+}
@@ -0,0 +1,167 @@
+package timeline
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/factory"
+	"github.com/timwehrle/asana/pkg/iostreams"
+)
+
+func TestNewCmdTimeline(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		wantFormat string
+		wantErr    string
+	}{
+		{name: "default", args: []string{"Website"}, wantFormat: "ascii"},
+		{name: "csv", args: []string{"-f", "csv"}, wantFormat: "csv"},
+		{name: "invalid", args: []string{"--format", "svg"}, wantErr: `invalid format "svg": expected ascii, mermaid or csv`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, _, _ := factory.NewTestFactory()
+
+			var got *TimelineOptions
+			cmd := NewCmdTimeline(f, func(opts *TimelineOptions) error {
+				got = opts
+				return nil
+			})
+			cmd.SetArgs(tt.args)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantFormat, got.Format)
+		})
+	}
+}
+
+func date(s string) *asana.Date {
+	d, _ := time.Parse(time.DateOnly, s)
+	ad := asana.Date(d)
+	return &ad
+}
+
+func newTask(id, name, section, start, due string) *asana.Task {
+	t := &asana.Task{ID: id}
+	t.Name = name
+	if start != "" {
+		t.StartOn = date(start)
+	}
+	if due != "" {
+		t.DueOn = date(due)
+	}
+	if section != "" {
+		t.Memberships = []*asana.Membership{{
+			Project: &asana.Project{ID: "p1"},
+			Section: &asana.Section{ID: section},
+		}}
+	}
+	return t
+}
+
+func testTimeline() *timeline {
+	done := true
+
+	design := newTask("1", "Design", "s1", "2025-03-01", "2025-03-05")
+	design.Completed = &done
+	build := newTask("2", "Build: API", "s2", "2025-03-06", "2025-03-10")
+	build.Dependencies = []*asana.Task{{ID: "1"}, {ID: "9"}}
+	launch := newTask("3", "Launch", "s2", "", "2025-03-10")
+	launch.ResourceSubtype = "milestone"
+	launch.Dependencies = []*asana.Task{{ID: "2"}}
+	docs := newTask("4", "Docs", "s1", "", "")
+
+	var sections []*asana.Section
+	for _, id := range []string{"s1", "s2"} {
+		s := &asana.Section{ID: id}
+		s.Name = map[string]string{"s1": "Plan", "s2": "Ship"}[id]
+		sections = append(sections, s)
+	}
+
+	project := &asana.Project{ID: "p1"}
+	project.Name = "Website"
+
+	return buildTimeline(project, sections, []*asana.Task{design, build, launch, docs})
+}
+
+func TestBuildTimeline(t *testing.T) {
+	tl := testTimeline()
+
+	require.Len(t, tl.Groups, 2)
+	assert.Equal(t, "Plan", tl.Groups[0].Name)
+	assert.Len(t, tl.Groups[0].Items, 1)
+	assert.Len(t, tl.Groups[1].Items, 2)
+	assert.True(t, tl.Groups[1].Items[1].Milestone)
+
+	require.Len(t, tl.Undated, 1)
+	assert.Equal(t, "Docs", tl.Undated[0].Items[0].Task.Name)
+
+	first, last := tl.span()
+	assert.Equal(t, "2025-03-01", first.Format(time.DateOnly))
+	assert.Equal(t, "2025-03-10", last.Format(time.DateOnly))
+}
+
+func TestWriteMermaid(t *testing.T) {
+	var buf bytes.Buffer
+	writeMermaid(&buf, testTimeline(), time.Date(2025, 3, 8, 0, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, heredoc.Doc(`
+		gantt
+		  title Website
+		  dateFormat YYYY-MM-DD
+		  section Plan
+		    Design :done, t1, 2025-03-01, 2025-03-06
+		  section Ship
+		    Build API :t2, after t1, 2025-03-11
+		    Launch :milestone, t3, after t2, 0d
+		  %% Without dates: Docs (Plan)
+	`), buf.String())
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, writeCSV(&buf, testTimeline()))
+
+	assert.Equal(t, heredoc.Doc(`
+		id,name,section,type,start,end,completed,dependencies
+		1,Design,Plan,task,2025-03-01,2025-03-05,true,
+		2,Build: API,Ship,task,2025-03-06,2025-03-10,false,1;9
+		3,Launch,Ship,milestone,2025-03-10,2025-03-10,false,2
+		4,Docs,Plan,task,,,false,
+	`), buf.String())
+}
+
+func TestWriteASCII(t *testing.T) {
+	ios, _, out, _ := iostreams.Test()
+	writeASCII(ios, testTimeline(), 52, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, heredoc.Doc(`
+		Website
+
+		              Mar 01, 2025              Mar 10, 2025
+		Plan
+		  Design      ░░░░░░░░░░░░░░░░░░░
+		Ship
+		  Build: API                     ███████████████████
+		  Launch                                        ◆
+
+		█ open  ░ completed  ◆ milestone  █ overdue
+
+		Without dates
+		  • Docs (Plan)
+	`), out.String())
+}
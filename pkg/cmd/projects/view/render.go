@@ -0,0 +1,154 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/format"
+	"github.com/timwehrle/asana/pkg/iostreams"
+)
+
+// barWidth is the number of cells of a progress bar.
+const barWidth = 20
+
+var privacyNames = map[asana.PrivacySetting]string{
+	asana.PrivacySettingWorkspace: "Workspace",
+	asana.PrivacySettingTeam:      "Team",
+	asana.PrivacySettingPrivate:   "Private",
+}
+
+// writeDashboard prints the project overview.
+func writeDashboard(ios *iostreams.IOStreams, d *dashboard) {
+	cs := ios.ColorScheme()
+	out := ios.Out
+	p := d.Project
+
+	title := cs.Bold(p.Name)
+	if p.Archived != nil && *p.Archived {
+		title += " " + cs.Gray("(archived)")
+	}
+	fmt.Fprintf(out, "%s %s\n\n", title, cs.Gray(p.ID))
+
+	field := func(label, value string) {
+		if value != "" {
+			fmt.Fprintf(out, "%s %s\n", cs.Gray(fmt.Sprintf("%-9s", label+":")), value)
+		}
+	}
+
+	if p.Owner != nil {
+		field("Owner", p.Owner.Name)
+	} else {
+		field("Owner", "None")
+	}
+	if p.Team != nil {
+		field("Team", p.Team.Name)
+	}
+	if p.StartOn != nil || p.DueOn != nil {
+		field("Dates", dates(p))
+	}
+	field("Privacy", privacyNames[p.PrivacySetting])
+	field("View", string(p.DefaultView))
+	if p.CurrentStatus != nil {
+		field("Status", status(ios, p.CurrentStatus))
+	}
+
+	fmt.Fprintf(out, "%s %s %d%% %s\n",
+		cs.Gray(fmt.Sprintf("%-9s", "Progress:")),
+		format.ProgressBar(d.Tasks.Completed, d.Tasks.Total, barWidth),
+		d.Tasks.Percent,
+		cs.Gray(fmt.Sprintf("(%d of %d tasks)", d.Tasks.Completed, d.Tasks.Total)))
+	if d.Tasks.Overdue > 0 {
+		field("Overdue", ios.ColorFromScheme(tasks(d.Tasks.Overdue), cs.Error))
+	}
+
+	if notes := strings.TrimSpace(p.Notes); notes != "" {
+		fmt.Fprintf(out, "\n%s\n", format.Indent(notes, "  "))
+	}
+
+	if len(d.Sections) > 0 {
+		fmt.Fprintf(out, "\n%s\n", cs.Bold("Sections"))
+
+		nameWidth := 0
+		for _, s := range d.Sections {
+			nameWidth = max(nameWidth, utf8.RuneCountInString(s.Name))
+		}
+		for _, s := range d.Sections {
+			padding := strings.Repeat(" ", nameWidth-utf8.RuneCountInString(s.Name))
+			fmt.Fprintf(out, "  %s%s  %s %3d%% %s\n",
+				s.Name, padding, format.ProgressBar(s.Completed, s.Total, barWidth), format.Percent(s.Completed, s.Total),
+				cs.Gray(fmt.Sprintf("%d/%d", s.Completed, s.Total)))
+		}
+	}
+
+	fmt.Fprintf(out, "\n%s\n", cs.Bold(fmt.Sprintf("Members (%d)", len(d.Members))))
+	for _, m := range d.Members {
+		if m.Member == nil {
+			continue
+		}
+		line := "  • " + m.Member.Name
+		if m.AccessLevel != "" {
+			line += " " + cs.Gray(string(m.AccessLevel))
+		}
+		fmt.Fprintln(out, line)
+	}
+
+	if len(p.CustomFieldSettings) > 0 {
+		fmt.Fprintf(out, "\n%s\n", cs.Bold("Custom fields"))
+		for _, s := range p.CustomFieldSettings {
+			if s.CustomField == nil {
+				continue
+			}
+			marker := " "
+			if s.Important {
+				marker = "★"
+			}
+			fmt.Fprintf(out, "  %s %s %s\n", marker, s.CustomField.Name, cs.Gray(string(s.CustomField.ResourceSubtype)))
+		}
+	}
+}
+
+func dates(p *asana.Project) string {
+	switch {
+	case p.StartOn != nil && p.DueOn != nil:
+		return format.Date(p.StartOn) + " → " + format.Date(p.DueOn)
+	case p.DueOn != nil:
+		return "Due " + format.Date(p.DueOn)
+	default:
+		return "Starts " + format.Date(p.StartOn)
+	}
+}
+
+func status(ios *iostreams.IOStreams, s *asana.ProjectStatus) string {
+	cs := ios.ColorScheme()
+
+	var dot string
+	switch s.Color {
+	case "green":
+		dot = ios.ColorFromScheme("●", cs.Success)
+	case "yellow":
+		dot = ios.ColorFromScheme("●", cs.Warning)
+	case "red":
+		dot = ios.ColorFromScheme("●", cs.Error)
+	default:
+		dot = "●"
+	}
+
+	line := dot
+	if text := strings.TrimSpace(s.Text); text != "" {
+		line += " " + strings.SplitN(text, "\n", 2)[0]
+	}
+	if s.Author != nil && s.Author.Name != "" {
+		line += " " + cs.Gray("by "+s.Author.Name)
+	}
+	return line
+}
+
+// bar draws a progress bar of completed out of total.
+func tasks(n int) string {
+	if n == 1 {
+		return "1 task"
+	}
+	return fmt.Sprintf("%d tasks", n)
+}
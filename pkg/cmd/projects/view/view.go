@@ -0,0 +1,202 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+	"github.com/timwehrle/asana/pkg/format"
+)
+
+type ViewOptions struct {
+	cmdutils.BaseOptions
+
+	Project string
+	JSON    bool
+
+	now func() time.Time
+}
+
+// projectFields are the project fields shown on the dashboard.
+var projectFields = []string{
+	"name", "notes", "color", "icon", "archived", "default_view", "privacy_setting",
+	"start_on", "due_on", "created_at", "modified_at", "owner.name", "team.name",
+	"current_status.color", "current_status.text", "current_status.author.name",
+	"custom_field_settings.is_important", "custom_field_settings.custom_field.name",
+	"custom_field_settings.custom_field.resource_subtype",
+}
+
+// taskFields are the task fields needed to count progress per section.
+var taskFields = []string{"completed", "due_on", "due_at", "memberships.project", "memberships.section"}
+
+// noSection is the name of the group holding tasks outside of any section.
+const noSection = "(no section)"
+
+// sectionSummary counts the tasks of a section.
+type sectionSummary struct {
+	ID        string `json:"gid,omitempty"`
+	Name      string `json:"name"`
+	Total     int    `json:"total"`
+	Completed int    `json:"completed"`
+}
+
+// taskSummary counts the tasks of the whole project.
+type taskSummary struct {
+	Total     int `json:"total"`
+	Completed int `json:"completed"`
+	Overdue   int `json:"overdue"`
+	Percent   int `json:"percent_complete"`
+}
+
+// dashboard is everything shown about a project, and the --json output.
+type dashboard struct {
+	Project  *asana.Project             `json:"project"`
+	Members  []*asana.ProjectMembership `json:"members"`
+	Sections []*sectionSummary          `json:"sections"`
+	Tasks    taskSummary                `json:"tasks"`
+}
+
+func NewCmdView(f factory.Factory, runF func(*ViewOptions) error) *cobra.Command {
+	opts := &ViewOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+		now: time.Now,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view [<project>]",
+		Short: "View a project",
+		Long: heredoc.Doc(`
+				Show an overview of a project: its owner, team, dates, privacy, current
+				status, members and custom fields, and the progress of each section.
+
+				Inside a linked directory the linked project is used instead of prompting.
+			`),
+		Example: heredoc.Doc(`
+				$ asana projects view "Website"
+				$ asana projects view 1204567890123 --json
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Project = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runView(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.JSON, "json", false, "Output the project as JSON")
+
+	return cmd
+}
+
+func runView(opts *ViewOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	project, err := cmdutils.ResolveProject(client, cfg, opts.Prompter, opts.Project)
+	if err != nil {
+		return err
+	}
+
+	if err := project.Fetch(client, &asana.Options{Fields: projectFields}); err != nil {
+		return fmt.Errorf("failed to fetch project: %w", err)
+	}
+
+	members, err := project.AllMemberships(client, &asana.Options{Fields: []string{"member.name", "access_level"}})
+	if err != nil {
+		return fmt.Errorf("failed to fetch members of project %q: %w", project.Name, err)
+	}
+
+	sections, err := cmdutils.AllSections(client, project)
+	if err != nil {
+		return fmt.Errorf("failed to fetch sections: %w", err)
+	}
+
+	tasks, err := cmdutils.AllProjectTasks(client, project, taskFields)
+	if err != nil {
+		return fmt.Errorf("failed to fetch tasks for project %q: %w", project.Name, err)
+	}
+
+	d := summarize(project, sections, tasks, opts.now())
+	d.Members = members
+
+	if opts.JSON {
+		enc := json.NewEncoder(opts.IO.Out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(d)
+	}
+
+	writeDashboard(opts.IO, d)
+	return nil
+}
+
+// summarize counts the tasks of the project per section. Sections keep the
+// project order; tasks outside of any known section are counted in a trailing
+// group.
+func summarize(project *asana.Project, sections []*asana.Section, tasks []*asana.Task, now time.Time) *dashboard {
+	d := &dashboard{Project: project, Sections: make([]*sectionSummary, 0, len(sections))}
+
+	bySection := make(map[string]*sectionSummary, len(sections))
+	for _, s := range sections {
+		summary := &sectionSummary{ID: s.ID, Name: s.Name}
+		bySection[s.ID] = summary
+		d.Sections = append(d.Sections, summary)
+	}
+
+	var rest *sectionSummary
+	for _, t := range tasks {
+		summary := bySection[sectionOf(t, project.ID)]
+		if summary == nil {
+			if rest == nil {
+				rest = &sectionSummary{Name: noSection}
+			}
+			summary = rest
+		}
+
+		summary.Total++
+		d.Tasks.Total++
+		if t.Completed != nil && *t.Completed {
+			summary.Completed++
+			d.Tasks.Completed++
+		} else if cmdutils.IsOverdue(t, now) {
+			d.Tasks.Overdue++
+		}
+	}
+	if rest != nil {
+		d.Sections = append(d.Sections, rest)
+	}
+
+	d.Tasks.Percent = format.Percent(d.Tasks.Completed, d.Tasks.Total)
+	return d
+}
+
+func sectionOf(t *asana.Task, projectID string) string {
+	for _, m := range t.Memberships {
+		if m.Project != nil && m.Project.ID == projectID && m.Section != nil {
+			return m.Section.ID
+		}
+	}
+	return ""
+}
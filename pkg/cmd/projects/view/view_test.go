@@ -0,0 +1,137 @@
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/factory"
+	"github.com/timwehrle/asana/pkg/iostreams"
+)
+
+func TestNewCmdView(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		wantProject string
+		wantJSON    bool
+		wantErr     string
+	}{
+		{name: "no args", args: []string{}},
+		{name: "project and json", args: []string{"Website", "--json"}, wantProject: "Website", wantJSON: true},
+		{name: "too many args", args: []string{"a", "b"}, wantErr: "accepts at most 1 arg(s), received 2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, _, _ := factory.NewTestFactory()
+
+			var got *ViewOptions
+			cmd := NewCmdView(f, func(opts *ViewOptions) error {
+				got = opts
+				return nil
+			})
+			cmd.SetArgs(tt.args)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantProject, got.Project)
+			assert.Equal(t, tt.wantJSON, got.JSON)
+		})
+	}
+}
+
+func newTask(id, section string, completed bool, due string) *asana.Task {
+	t := &asana.Task{ID: id}
+	t.Completed = &completed
+	if due != "" {
+		d, _ := time.Parse(time.DateOnly, due)
+		date := asana.Date(d)
+		t.DueOn = &date
+	}
+	if section != "" {
+		t.Memberships = []*asana.Membership{{
+			Project: &asana.Project{ID: "p1"},
+			Section: &asana.Section{ID: section},
+		}}
+	}
+	return t
+}
+
+func newSection(id, name string) *asana.Section {
+	s := &asana.Section{ID: id}
+	s.Name = name
+	return s
+}
+
+func testDashboard() *dashboard {
+	project := &asana.Project{ID: "p1"}
+	project.Name = "Website"
+	project.Owner = &asana.User{Name: "Jane Doe"}
+	project.PrivacySetting = asana.PrivacySettingTeam
+	project.CurrentStatus = &asana.ProjectStatus{Color: "green", Text: "On track\nDetails", Author: &asana.User{Name: "Jane Doe"}}
+	field := &asana.CustomField{}
+	field.Name = "Priority"
+	project.CustomFieldSettings = []*asana.CustomFieldSetting{{CustomField: field, Important: true}}
+
+	sections := []*asana.Section{newSection("s1", "Backlog"), newSection("s2", "Done")}
+	tasks := []*asana.Task{
+		newTask("t1", "s1", false, "2025-03-01"),
+		newTask("t2", "s1", false, "2025-04-01"),
+		newTask("t3", "s2", true, "2025-02-01"),
+		newTask("t4", "s2", true, ""),
+		newTask("t5", "", false, "2025-03-09"),
+	}
+
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	return summarize(project, sections, tasks, now)
+}
+
+func TestSummarize(t *testing.T) {
+	d := testDashboard()
+
+	assert.Equal(t, taskSummary{Total: 5, Completed: 2, Overdue: 2, Percent: 40}, d.Tasks)
+	assert.Equal(t, []*sectionSummary{
+		{ID: "s1", Name: "Backlog", Total: 2},
+		{ID: "s2", Name: "Done", Total: 2, Completed: 2},
+		{Name: noSection, Total: 1},
+	}, d.Sections)
+}
+
+func TestSummarizeEmpty(t *testing.T) {
+	d := summarize(&asana.Project{ID: "p1"}, nil, nil, time.Now())
+
+	assert.Equal(t, taskSummary{}, d.Tasks)
+	assert.Empty(t, d.Sections)
+}
+
+func TestWriteDashboard(t *testing.T) {
+	ios, _, out, _ := iostreams.Test()
+
+	d := testDashboard()
+	d.Members = []*asana.ProjectMembership{
+		{Member: &asana.ProjectMember{Name: "Jane Doe"}, AccessLevel: asana.AccessLevelAdmin},
+	}
+	writeDashboard(ios, d)
+
+	got := out.String()
+	assert.Contains(t, got, "Website")
+	assert.Contains(t, got, "Jane Doe")
+	assert.Contains(t, got, "Team\n")
+	assert.Contains(t, got, "● On track")
+	assert.NotContains(t, got, "Details")
+	assert.Contains(t, got, "████████░░░░░░░░░░░░ 40%")
+	assert.Contains(t, got, "2 tasks")
+	assert.Contains(t, got, "  Backlog       ░░░░░░░░░░░░░░░░░░░░   0%")
+	assert.Contains(t, got, "  Done          ████████████████████ 100%")
+	assert.Contains(t, got, "Members (1)")
+	assert.Contains(t, got, "★ Priority")
+}
@@ -14,8 +14,15 @@ import (
 	"github.com/timwehrle/asana/internal/build"
 	"github.com/timwehrle/asana/pkg/cmd/auth"
 	"github.com/timwehrle/asana/pkg/cmd/config"
+	"github.com/timwehrle/asana/pkg/cmd/fields"
+	gitcmd "github.com/timwehrle/asana/pkg/cmd/git"
+	"github.com/timwehrle/asana/pkg/cmd/goals"
+	"github.com/timwehrle/asana/pkg/cmd/link"
+	"github.com/timwehrle/asana/pkg/cmd/portfolios"
 	"github.com/timwehrle/asana/pkg/cmd/projects"
+	"github.com/timwehrle/asana/pkg/cmd/sections"
 	"github.com/timwehrle/asana/pkg/cmd/tasks"
+	"github.com/timwehrle/asana/pkg/cmd/unlink"
 	"github.com/timwehrle/asana/pkg/cmd/users"
 	"github.com/timwehrle/asana/pkg/cmd/workspaces"
 	"github.com/timwehrle/asana/pkg/factory"
@@ -66,12 +73,19 @@ func NewCmdRoot(f factory.Factory, buildVersion string) (*cobra.Command, error)
 	// Add other commands
 	cmd.AddCommand(tasks.NewCmdTasks(f))
 	cmd.AddCommand(projects.NewCmdProjects(f))
+	cmd.AddCommand(portfolios.NewCmdPortfolios(f))
+	cmd.AddCommand(goals.NewCmdGoals(f))
+	cmd.AddCommand(sections.NewCmdSections(f))
+	cmd.AddCommand(fields.NewCmdFields(f))
 	cmd.AddCommand(workspaces.NewCmdWorkspace(f))
 	cmd.AddCommand(users.NewCmdUsers(f))
 	cmd.AddCommand(config.NewCmdConfig(f))
 	cmd.AddCommand(tags.NewCmdTags(f))
 	cmd.AddCommand(teams.NewCmdTeams(f))
 	cmd.AddCommand(time.NewCmdTimer(f))
+	cmd.AddCommand(link.NewCmdLink(f, nil))
+	cmd.AddCommand(unlink.NewCmdUnlink(f, nil))
+	cmd.AddCommand(gitcmd.NewCmdGit(f))
 
 	cmd.SilenceErrors = true
 	cmd.SilenceUsage = true
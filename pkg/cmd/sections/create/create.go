@@ -0,0 +1,122 @@
+package create
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/sections/move"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type CreateOptions struct {
+	cmdutils.BaseOptions
+
+	Name    string
+	Project string
+	Before  string
+	After   string
+}
+
+func NewCmdCreate(f factory.Factory, runF func(*CreateOptions) error) *cobra.Command {
+	opts := &CreateOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create [<name>]",
+		Short: "Create a section in a project",
+		Long: heredoc.Doc(`
+				Create a section in a project. New sections are added at the end of the
+				project unless placed with --before or --after.
+			`),
+		Example: heredoc.Doc(`
+				$ asana sections create "In Review"
+				$ asana sections create "In Review" --project "Website" --after "In Progress"
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Name = args[0]
+			}
+			if opts.Before != "" && opts.After != "" {
+				return errors.New("specify only one of --before or --after")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runCreate(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Project, "project", "p", "", "Project name or ID (defaults to the linked project)")
+	cmd.Flags().StringVar(&opts.Before, "before", "", "Place the section before this section")
+	cmd.Flags().StringVar(&opts.After, "after", "", "Place the section after this section")
+
+	return cmd
+}
+
+func runCreate(opts *CreateOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	project, err := cmdutils.ResolveProject(client, cfg, opts.Prompter, opts.Project)
+	if err != nil {
+		return err
+	}
+
+	name := strings.TrimSpace(opts.Name)
+	if name == "" {
+		name, err = opts.Prompter.Input("Section name:", "")
+		if err != nil {
+			return fmt.Errorf("failed to read section name: %w", err)
+		}
+		name = strings.TrimSpace(name)
+	}
+	if name == "" {
+		return errors.New("section name cannot be empty")
+	}
+
+	// Resolve the anchor first, so a typo does not leave a misplaced section.
+	var anchor *asana.Section
+	if ref := opts.Before + opts.After; ref != "" {
+		anchor, err = cmdutils.ResolveSection(client, cfg, opts.Prompter, project, ref)
+		if err != nil {
+			return err
+		}
+	}
+
+	section, err := project.CreateSection(client, &asana.SectionBase{Name: name})
+	if err != nil {
+		return fmt.Errorf("failed to create section: %w", err)
+	}
+
+	if anchor != nil {
+		if err := move.Insert(client, project, section, anchor, opts.Before != ""); err != nil {
+			return fmt.Errorf("created section %q but failed to move it: %w", name, err)
+		}
+	}
+
+	opts.IO.Printf("%s Created section %s in %s %s\n", cs.SuccessIcon, cs.Bold(name), project.Name, cs.Gray(section.ID))
+	return nil
+}
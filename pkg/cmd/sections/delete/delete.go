@@ -0,0 +1,115 @@
+package delete
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/pkg/cmd/sections/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type DeleteOptions struct {
+	cmdutils.BaseOptions
+
+	Section string
+	Project string
+	Yes     bool
+}
+
+func NewCmdDelete(f factory.Factory, runF func(*DeleteOptions) error) *cobra.Command {
+	opts := &DeleteOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete [<section>]",
+		Short: "Delete an empty section",
+		Long: heredoc.Doc(`
+				Delete a section of a project. Asana only deletes empty sections, so the
+				command reports how many tasks the section still holds and stops if it
+				is not empty. Move the tasks to another section first.
+			`),
+		Example: heredoc.Doc(`
+				$ asana sections delete "Old ideas"
+				$ asana sections delete 1204567890123 --yes
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Section = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runDelete(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Project, "project", "p", "", "Project name or ID (defaults to the linked project)")
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Delete without asking for confirmation")
+
+	return cmd
+}
+
+func runDelete(opts *DeleteOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	project, err := cmdutils.ResolveProject(client, cfg, opts.Prompter, opts.Project)
+	if err != nil {
+		return err
+	}
+
+	section, err := shared.SelectSection(&opts.BaseOptions, client, project, opts.Section, "Select a section to delete:")
+	if err != nil {
+		return err
+	}
+
+	count, err := shared.CountSectionTasks(client, section)
+	if err != nil {
+		return fmt.Errorf("failed to count tasks in section %q: %w", section.Name, err)
+	}
+	if count > 0 {
+		noun := "tasks"
+		if count == 1 {
+			noun = "task"
+		}
+		return fmt.Errorf("section %q still holds %d %s; move them to another section before deleting it", section.Name, count, noun)
+	}
+
+	if !opts.Yes {
+		ok, err := opts.Prompter.Confirm(fmt.Sprintf("Delete section %q from %s?", section.Name, project.Name), "No")
+		if err != nil {
+			return fmt.Errorf("failed to confirm: %w", err)
+		}
+		if !ok {
+			opts.IO.Printf("%s Section kept\n", cs.WarningIcon)
+			return nil
+		}
+	}
+
+	if err := section.Delete(client); err != nil {
+		return fmt.Errorf("failed to delete section: %w", err)
+	}
+
+	opts.IO.Printf("%s Deleted section %s\n", cs.SuccessIcon, cs.Bold(section.Name))
+	return nil
+}
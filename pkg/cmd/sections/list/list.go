@@ -0,0 +1,108 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/pkg/cmd/sections/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type ListOptions struct {
+	cmdutils.BaseOptions
+
+	Project string
+	Counts  bool
+}
+
+func NewCmdList(f factory.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List the sections of a project",
+		Long: heredoc.Doc(`
+				List the sections of a project in order.
+
+				Inside a linked directory the linked project is used instead of prompting.
+			`),
+		Example: heredoc.Doc(`
+				$ asana sections list
+				$ asana sections list --project "Website" --counts
+			`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runList(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Project, "project", "p", "", "Project name or ID (defaults to the linked project)")
+	cmd.Flags().BoolVarP(&opts.Counts, "counts", "c", false, "Show the number of tasks in each section")
+
+	return cmd
+}
+
+func runList(opts *ListOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	project, err := cmdutils.ResolveProject(client, cfg, opts.Prompter, opts.Project)
+	if err != nil {
+		return err
+	}
+
+	sections, err := cmdutils.AllSections(client, project)
+	if err != nil {
+		return fmt.Errorf("cannot fetch sections: %w", err)
+	}
+
+	if len(sections) == 0 {
+		opts.IO.Printf("No sections in %s\n", cs.Bold(project.Name))
+		return nil
+	}
+
+	opts.IO.Printf("Sections in %s:\n\n", cs.Bold(project.Name))
+	for i, section := range sections {
+		line := fmt.Sprintf("%d. %s", i+1, cs.Bold(section.Name))
+		if opts.Counts {
+			count, err := shared.CountSectionTasks(client, section)
+			if err != nil {
+				return fmt.Errorf("failed to count tasks in section %q: %w", section.Name, err)
+			}
+			line += fmt.Sprintf(" (%s)", tasks(count))
+		}
+		opts.IO.Printf("%s %s\n", line, cs.Gray(section.ID))
+	}
+
+	return nil
+}
+
+func tasks(n int) string {
+	if n == 1 {
+		return "1 task"
+	}
+	return fmt.Sprintf("%d tasks", n)
+}
@@ -0,0 +1,126 @@
+package move
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/sections/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type MoveOptions struct {
+	cmdutils.BaseOptions
+
+	Section string
+	Project string
+	Before  string
+	After   string
+}
+
+func NewCmdMove(f factory.Factory, runF func(*MoveOptions) error) *cobra.Command {
+	opts := &MoveOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "move [<section>] {--before <section> | --after <section>}",
+		Short: "Reorder a section within its project",
+		Long: heredoc.Doc(`
+				Move a section before or after another section of the same project.
+				Sections can be given by name or ID.
+			`),
+		Example: heredoc.Doc(`
+				$ asana sections move "Done" --after "In Review"
+				$ asana sections move --before "Backlog" --project "Website"
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Section = args[0]
+			}
+			if (opts.Before == "") == (opts.After == "") {
+				return errors.New("specify exactly one of --before or --after")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runMove(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Project, "project", "p", "", "Project name or ID (defaults to the linked project)")
+	cmd.Flags().StringVar(&opts.Before, "before", "", "Place the section before this section")
+	cmd.Flags().StringVar(&opts.After, "after", "", "Place the section after this section")
+
+	return cmd
+}
+
+func runMove(opts *MoveOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	project, err := cmdutils.ResolveProject(client, cfg, opts.Prompter, opts.Project)
+	if err != nil {
+		return err
+	}
+
+	section, err := shared.SelectSection(&opts.BaseOptions, client, project, opts.Section, "Select a section to move:")
+	if err != nil {
+		return err
+	}
+
+	anchor, err := cmdutils.ResolveSection(client, cfg, opts.Prompter, project, opts.Before+opts.After)
+	if err != nil {
+		return err
+	}
+	if anchor.ID == section.ID {
+		return errors.New("cannot move a section relative to itself")
+	}
+
+	before := opts.Before != ""
+	if err := Insert(client, project, section, anchor, before); err != nil {
+		return fmt.Errorf("failed to move section: %w", err)
+	}
+
+	position := "after"
+	if before {
+		position = "before"
+	}
+	opts.IO.Printf("%s Moved %s %s %s\n", cs.SuccessIcon, cs.Bold(section.Name), position, cs.Bold(anchor.Name))
+	return nil
+}
+
+// Insert moves section directly before or after anchor.
+func Insert(client *asana.Client, project *asana.Project, section, anchor *asana.Section, before bool) error {
+	request := &asana.SectionInsertRequest{
+		Project: project.ID,
+		Section: section.ID,
+	}
+	if before {
+		request.BeforeSection = anchor.ID
+	} else {
+		request.AfterSection = anchor.ID
+	}
+
+	return project.InsertSection(client, request)
+}
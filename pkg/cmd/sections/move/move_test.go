@@ -0,0 +1,50 @@
+package move
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+func TestNewCmdMove(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		wantSection string
+		wantBefore  string
+		wantAfter   string
+		wantErr     string
+	}{
+		{name: "after", args: []string{"Done", "--after", "Review"}, wantSection: "Done", wantAfter: "Review"},
+		{name: "before with selection", args: []string{"--before", "Backlog"}, wantBefore: "Backlog"},
+		{name: "no position", args: []string{"Done"}, wantErr: "specify exactly one of --before or --after"},
+		{name: "both positions", args: []string{"Done", "--before", "A", "--after", "B"}, wantErr: "specify exactly one of --before or --after"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, _, _ := factory.NewTestFactory()
+
+			var sawOpts *MoveOptions
+			cmd := NewCmdMove(f, func(opts *MoveOptions) error {
+				sawOpts = opts
+				return nil
+			})
+			cmd.SetArgs(tt.args)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSection, sawOpts.Section)
+			assert.Equal(t, tt.wantBefore, sawOpts.Before)
+			assert.Equal(t, tt.wantAfter, sawOpts.After)
+		})
+	}
+}
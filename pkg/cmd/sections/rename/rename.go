@@ -0,0 +1,114 @@
+package rename
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/sections/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type RenameOptions struct {
+	cmdutils.BaseOptions
+
+	Section string
+	Name    string
+	Project string
+}
+
+func NewCmdRename(f factory.Factory, runF func(*RenameOptions) error) *cobra.Command {
+	opts := &RenameOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "rename [<section> [<new-name>]]",
+		Short: "Rename a section",
+		Long: heredoc.Doc(`
+				Rename a section of a project. Without arguments, select the section and
+				enter the new name interactively.
+			`),
+		Example: heredoc.Doc(`
+				$ asana sections rename "Doing" "In Progress"
+				$ asana sections rename --project "Website"
+			`),
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Section = args[0]
+			}
+			if len(args) > 1 {
+				opts.Name = args[1]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runRename(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Project, "project", "p", "", "Project name or ID (defaults to the linked project)")
+
+	return cmd
+}
+
+func runRename(opts *RenameOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	project, err := cmdutils.ResolveProject(client, cfg, opts.Prompter, opts.Project)
+	if err != nil {
+		return err
+	}
+
+	section, err := shared.SelectSection(&opts.BaseOptions, client, project, opts.Section, "Select a section to rename:")
+	if err != nil {
+		return err
+	}
+
+	name := strings.TrimSpace(opts.Name)
+	if name == "" {
+		name, err = opts.Prompter.Input("New name:", section.Name)
+		if err != nil {
+			return fmt.Errorf("failed to read section name: %w", err)
+		}
+		name = strings.TrimSpace(name)
+	}
+	if name == "" {
+		return errors.New("section name cannot be empty")
+	}
+	if name == section.Name {
+		opts.IO.Printf("%s Section is already named %s\n", cs.WarningIcon, cs.Bold(name))
+		return nil
+	}
+
+	if _, err := section.Update(client, &asana.UpdateSectionRequest{
+		SectionBase: asana.SectionBase{Name: name},
+	}); err != nil {
+		return fmt.Errorf("failed to rename section: %w", err)
+	}
+
+	opts.IO.Printf("%s Renamed %s to %s\n", cs.SuccessIcon, section.Name, cs.Bold(name))
+	return nil
+}
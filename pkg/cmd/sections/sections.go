@@ -0,0 +1,28 @@
+package sections
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/pkg/cmd/sections/create"
+	"github.com/timwehrle/asana/pkg/cmd/sections/delete"
+	"github.com/timwehrle/asana/pkg/cmd/sections/list"
+	"github.com/timwehrle/asana/pkg/cmd/sections/move"
+	"github.com/timwehrle/asana/pkg/cmd/sections/rename"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+func NewCmdSections(f factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "sections <subcommand>",
+		Aliases: []string{"section"},
+		Short:   "Manage the sections of a project",
+		Long:    "Perform operations related to the sections of an Asana project.",
+	}
+
+	cmd.AddCommand(list.NewCmdList(f, nil))
+	cmd.AddCommand(create.NewCmdCreate(f, nil))
+	cmd.AddCommand(rename.NewCmdRename(f, nil))
+	cmd.AddCommand(delete.NewCmdDelete(f, nil))
+	cmd.AddCommand(move.NewCmdMove(f, nil))
+
+	return cmd
+}
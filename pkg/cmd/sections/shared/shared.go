@@ -0,0 +1,60 @@
+package shared
+
+import (
+	"fmt"
+
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/format"
+)
+
+// SelectSection returns the section of project identified by nameOrID, or
+// asks the user to select one. Unlike cmdutils.ResolveSection, the linked
+// section is never used implicitly, since the commands using this change or
+// delete the section.
+func SelectSection(opts *cmdutils.BaseOptions, client *asana.Client, project *asana.Project, nameOrID, message string) (*asana.Section, error) {
+	if nameOrID != "" {
+		cfg, err := opts.Config()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load config: %w", err)
+		}
+		return cmdutils.ResolveSection(client, cfg, opts.Prompter, project, nameOrID)
+	}
+
+	sections, err := cmdutils.AllSections(client, project)
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch sections: %w", err)
+	}
+	if len(sections) == 0 {
+		return nil, fmt.Errorf("no sections found in project %q", project.Name)
+	}
+
+	names := format.MapToStrings(sections, func(s *asana.Section) string {
+		return s.Name
+	})
+
+	index, err := opts.Prompter.Select(message, names)
+	if err != nil {
+		return nil, fmt.Errorf("section selection failed: %w", err)
+	}
+	return sections[index], nil
+}
+
+// CountSectionTasks returns the number of tasks in a section.
+func CountSectionTasks(client *asana.Client, section *asana.Section) (int, error) {
+	count := 0
+	options := &asana.Options{Limit: 100, Fields: []string{"gid"}}
+
+	for {
+		batch, nextPage, err := section.Tasks(client, options)
+		if err != nil {
+			return 0, err
+		}
+		count += len(batch)
+
+		if nextPage == nil || nextPage.Offset == "" {
+			return count, nil
+		}
+		options.Offset = nextPage.Offset
+	}
+}
@@ -0,0 +1,104 @@
+package create
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/tags/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type CreateOptions struct {
+	cmdutils.BaseOptions
+
+	Name  string
+	Color string
+	Notes string
+}
+
+func NewCmdCreate(f factory.Factory, runF func(*CreateOptions) error) *cobra.Command {
+	opts := &CreateOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create a tag",
+		Long: heredoc.Doc(`
+				Create a tag in your default workspace. Tag names are unique regardless
+				of case.
+			`),
+		Example: heredoc.Doc(`
+				$ asana tags create urgent --color dark-red
+				$ asana tags create "needs review" --notes "Waiting for a second pair of eyes"
+			`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Name = strings.TrimSpace(args[0])
+			if opts.Name == "" {
+				return fmt.Errorf("tag name cannot be empty")
+			}
+			if opts.Color != "" {
+				if err := cmdutils.ValidateStringEnum("color", opts.Color, shared.Colors); err != nil {
+					return err
+				}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runCreate(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Color, "color", "", "Tag color, such as dark-red or light-blue")
+	cmd.Flags().StringVarP(&opts.Notes, "notes", "m", "", "Tag description")
+
+	return cmd
+}
+
+func runCreate(opts *CreateOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	ws := &asana.Workspace{ID: cfg.Workspace.ID, Name: cfg.Workspace.Name}
+	tags, err := ws.AllTags(client)
+	if err != nil {
+		return fmt.Errorf("failed to fetch tags: %w", err)
+	}
+	if found, _ := shared.FindTags(tags, []string{opts.Name}); len(found) > 0 {
+		return fmt.Errorf("tag %q already exists", found[0].Name)
+	}
+
+	base := &asana.TagBase{Name: opts.Name, Notes: opts.Notes, Color: opts.Color}
+	if base.Color == shared.NoColor {
+		base.Color = ""
+	}
+
+	tag, err := ws.CreateTag(client, base)
+	if err != nil {
+		return fmt.Errorf("failed to create tag: %w", err)
+	}
+
+	opts.IO.Printf("%s Created tag %s %s\n", cs.SuccessIcon, cs.Bold(tag.Name), cs.Gray(tag.ID))
+	return nil
+}
@@ -0,0 +1,93 @@
+package delete
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type DeleteOptions struct {
+	cmdutils.BaseOptions
+
+	Tag string
+	Yes bool
+}
+
+func NewCmdDelete(f factory.Factory, runF func(*DeleteOptions) error) *cobra.Command {
+	opts := &DeleteOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete <tag>",
+		Short: "Delete a tag",
+		Long: heredoc.Doc(`
+				Delete a tag from your workspace. The tag is removed from every task
+				that has it; the tasks themselves are kept.
+			`),
+		Example: heredoc.Doc(`
+				$ asana tags delete urgent
+				$ asana tags delete 1204567890123 --yes
+			`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Tag = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runDelete(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func runDelete(opts *DeleteOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	tags, err := cmdutils.ResolveTags(client, cfg, []string{opts.Tag})
+	if err != nil {
+		return err
+	}
+	tag := tags[0]
+
+	if !opts.Yes {
+		confirmed, err := opts.Prompter.Confirm(
+			fmt.Sprintf("Delete tag %s and remove it from all tasks?", tag.Name), "No")
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return nil
+		}
+	}
+
+	if err := tag.Delete(client); err != nil {
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+
+	opts.IO.Printf("%s Deleted tag %s\n", cs.SuccessIcon, cs.Bold(tag.Name))
+	return nil
+}
@@ -0,0 +1,130 @@
+package edit
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/tags/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type EditOptions struct {
+	cmdutils.BaseOptions
+
+	Tag string
+
+	// Base holds the tag fields set by flags.
+	Base asana.TagBase
+}
+
+func NewCmdEdit(f factory.Factory, runF func(*EditOptions) error) *cobra.Command {
+	opts := &EditOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "edit <tag>",
+		Short: "Rename or recolor a tag",
+		Long: heredoc.Doc(`
+				Change the name, color or description of a tag. Only the given flags are
+				changed. The tag is matched by name, regardless of case, or by ID.
+
+				Use --color none to remove the color of a tag.
+			`),
+		Example: heredoc.Doc(`
+				$ asana tags edit urgent --color dark-orange
+				$ asana tags edit "needs review" --name review
+			`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Tag = args[0]
+
+			if cmd.Flags().NFlag() == 0 {
+				return errors.New("specify at least one field to change")
+			}
+			if cmd.Flags().Changed("name") {
+				opts.Base.Name = strings.TrimSpace(opts.Base.Name)
+				if opts.Base.Name == "" {
+					return errors.New("tag name cannot be empty")
+				}
+			}
+			if cmd.Flags().Changed("color") {
+				if err := cmdutils.ValidateStringEnum("color", opts.Base.Color, shared.Colors); err != nil {
+					return err
+				}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runEdit(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Base.Name, "name", "n", "", "New tag name")
+	cmd.Flags().StringVar(&opts.Base.Color, "color", "", "Tag color, such as dark-red or light-blue")
+	cmd.Flags().StringVarP(&opts.Base.Notes, "notes", "m", "", "Tag description")
+
+	return cmd
+}
+
+func runEdit(opts *EditOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	ws := &asana.Workspace{ID: cfg.Workspace.ID}
+	tags, err := ws.AllTags(client)
+	if err != nil {
+		return fmt.Errorf("failed to fetch tags: %w", err)
+	}
+
+	found, _ := shared.FindTags(tags, []string{opts.Tag})
+	if len(found) == 0 {
+		return fmt.Errorf("tag %q not found in workspace", opts.Tag)
+	}
+	tag := found[0]
+
+	if opts.Base.Name != "" {
+		if other, _ := shared.FindTags(tags, []string{opts.Base.Name}); len(other) > 0 && other[0].ID != tag.ID {
+			return fmt.Errorf("tag %q already exists", other[0].Name)
+		}
+	}
+
+	request := &asana.UpdateTagRequest{TagBase: opts.Base}
+	if request.Color == shared.NoColor {
+		request.Color = ""
+		request.Clear = append(request.Clear, "color")
+	}
+
+	oldName := tag.Name
+	if err := tag.Update(client, request); err != nil {
+		return fmt.Errorf("failed to update tag: %w", err)
+	}
+
+	if tag.Name != oldName {
+		opts.IO.Printf("%s Renamed tag %s to %s\n", cs.SuccessIcon, cs.Bold(oldName), cs.Bold(tag.Name))
+	} else {
+		opts.IO.Printf("%s Updated tag %s\n", cs.SuccessIcon, cs.Bold(tag.Name))
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package edit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+func TestNewCmdEdit(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		wantName  string
+		wantColor string
+		wantErr   string
+	}{
+		{
+			name:      "rename and recolor",
+			args:      []string{"urgent", "--name", " blocker ", "--color", "dark-orange"},
+			wantName:  "blocker",
+			wantColor: "dark-orange",
+		},
+		{
+			name:      "remove color",
+			args:      []string{"urgent", "--color", "none"},
+			wantColor: "none",
+		},
+		{
+			name:    "no changes",
+			args:    []string{"urgent"},
+			wantErr: "specify at least one field to change",
+		},
+		{
+			name:    "empty name",
+			args:    []string{"urgent", "--name", " "},
+			wantErr: "tag name cannot be empty",
+		},
+		{
+			name:    "invalid color",
+			args:    []string{"urgent", "--color", "blue"},
+			wantErr: `invalid value "blue" for flag --color`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, _, _ := factory.NewTestFactory()
+
+			var sawOpts *EditOptions
+			cmd := NewCmdEdit(f, func(opts *EditOptions) error {
+				sawOpts = opts
+				return nil
+			})
+			cmd.SetArgs(tt.args)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "urgent", sawOpts.Tag)
+			assert.Equal(t, tt.wantName, sawOpts.Base.Name)
+			assert.Equal(t, tt.wantColor, sawOpts.Base.Color)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package shared
+
+import (
+	"strings"
+
+	"github.com/timwehrle/asana/internal/api/asana"
+)
+
+// NoColor is the color value that removes the color of a tag.
+const NoColor = "none"
+
+// Colors are the colors a tag can have.
+var Colors = []string{
+	NoColor,
+	"dark-pink", "dark-green", "dark-blue", "dark-red", "dark-teal", "dark-brown",
+	"dark-orange", "dark-purple", "dark-warm-gray",
+	"light-pink", "light-green", "light-blue", "light-red", "light-teal",
+	"light-yellow", "light-orange", "light-purple", "light-warm-gray",
+}
+
+// FindTags matches names against tags by GID or case-insensitive name. It
+// returns the matching tags, each once, and the names that matched no tag,
+// trimmed and each once regardless of case. Empty names are skipped.
+func FindTags(tags []*asana.Tag, names []string) ([]*asana.Tag, []string) {
+	var found []*asana.Tag
+	var missing []string
+	seen := make(map[string]bool)
+	seenMissing := make(map[string]bool)
+
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		var match *asana.Tag
+		for _, tag := range tags {
+			if tag.ID == name || strings.EqualFold(tag.Name, name) {
+				match = tag
+				break
+			}
+		}
+
+		switch {
+		case match == nil:
+			if key := strings.ToLower(name); !seenMissing[key] {
+				seenMissing[key] = true
+				missing = append(missing, name)
+			}
+		case !seen[match.ID]:
+			seen[match.ID] = true
+			found = append(found, match)
+		}
+	}
+
+	return found, missing
+}
@@ -0,0 +1,66 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/timwehrle/asana/internal/api/asana"
+)
+
+func tag(id, name string) *asana.Tag {
+	t := &asana.Tag{ID: id}
+	t.Name = name
+	return t
+}
+
+func TestFindTags(t *testing.T) {
+	urgent, backend := tag("1", "Urgent"), tag("2", "backend")
+	tags := []*asana.Tag{urgent, backend}
+
+	tests := []struct {
+		name        string
+		names       []string
+		wantFound   []*asana.Tag
+		wantMissing []string
+	}{
+		{
+			name:      "case-insensitive names",
+			names:     []string{"urgent", "BACKEND"},
+			wantFound: []*asana.Tag{urgent, backend},
+		},
+		{
+			name:      "by ID",
+			names:     []string{"2"},
+			wantFound: []*asana.Tag{backend},
+		},
+		{
+			name:      "duplicates once",
+			names:     []string{"Urgent", "1"},
+			wantFound: []*asana.Tag{urgent},
+		},
+		{
+			name:        "missing",
+			names:       []string{"urgent", "frontend"},
+			wantFound:   []*asana.Tag{urgent},
+			wantMissing: []string{"frontend"},
+		},
+		{
+			name:        "missing once regardless of case",
+			names:       []string{"foo", " Foo ", "", "FOO"},
+			wantMissing: []string{"foo"},
+		},
+		{
+			name:      "trimmed names",
+			names:     []string{"  urgent "},
+			wantFound: []*asana.Tag{urgent},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			found, missing := FindTags(tags, tt.names)
+			assert.Equal(t, tt.wantFound, found)
+			assert.Equal(t, tt.wantMissing, missing)
+		})
+	}
+}
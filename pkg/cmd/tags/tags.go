@@ -2,6 +2,9 @@ package tags
 
 import (
 	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/pkg/cmd/tags/create"
+	"github.com/timwehrle/asana/pkg/cmd/tags/delete"
+	"github.com/timwehrle/asana/pkg/cmd/tags/edit"
 	"github.com/timwehrle/asana/pkg/cmd/tags/list"
 	"github.com/timwehrle/asana/pkg/cmd/tags/tasks"
 	"github.com/timwehrle/asana/pkg/factory"
@@ -16,6 +19,9 @@ func NewCmdTags(f factory.Factory) *cobra.Command {
 
 	cmd.AddCommand(list.NewCmdList(f, nil))
 	cmd.AddCommand(tasks.NewCmdTasks(f, nil))
+	cmd.AddCommand(create.NewCmdCreate(f, nil))
+	cmd.AddCommand(edit.NewCmdEdit(f, nil))
+	cmd.AddCommand(delete.NewCmdDelete(f, nil))
 
 	return cmd
 }
@@ -0,0 +1,117 @@
+package attachments
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+	"github.com/timwehrle/asana/pkg/format"
+)
+
+type AttachmentsOptions struct {
+	cmdutils.BaseOptions
+
+	Task string
+	JSON bool
+}
+
+func NewCmdAttachments(f factory.Factory, runF func(*AttachmentsOptions) error) *cobra.Command {
+	opts := &AttachmentsOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:        f.IOStreams,
+			Prompter:  f.Prompter,
+			Config:    f.Config,
+			Client:    f.Client,
+			GitClient: f.GitClient,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "attachments [<task>]",
+		Short: "List the attachments of a task",
+		Long: heredoc.Doc(`
+				List the files attached to a task with their size, host and ID.
+				Download them with "asana tasks download".
+			`),
+		Example: heredoc.Doc(`
+				$ asana tasks attachments
+				$ asana tasks attachments 1204567890123 --json
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Task = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runAttachments(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.JSON, "json", false, "Output as JSON")
+
+	return cmd
+}
+
+func runAttachments(opts *AttachmentsOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	task, err := cmdutils.ResolveTask(&opts.BaseOptions, client, opts.Task, "Select a task to list attachments of:")
+	if err != nil {
+		return err
+	}
+
+	attachments, err := cmdutils.AllAttachments(client, task)
+	if err != nil {
+		return fmt.Errorf("failed to fetch attachments: %w", err)
+	}
+
+	if opts.JSON {
+		if attachments == nil {
+			attachments = []*asana.Attachment{}
+		}
+		enc := json.NewEncoder(opts.IO.Out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(attachments)
+	}
+
+	if len(attachments) == 0 {
+		opts.IO.Printf("No attachments on %s\n", cs.Bold(task.Name))
+		return nil
+	}
+
+	opts.IO.Printf("%s\n\n", cs.Bold(fmt.Sprintf("Attachments of %s (%d)", task.Name, len(attachments))))
+
+	width := 0
+	for _, a := range attachments {
+		width = max(width, len([]rune(a.Name)))
+	}
+
+	for _, a := range attachments {
+		size := "-"
+		if a.Size != nil {
+			size = format.Bytes(*a.Size)
+		}
+		added := ""
+		if a.CreatedAt != nil {
+			added = format.HumanDate(a.CreatedAt.Local())
+		}
+
+		name := a.Name + fmt.Sprintf("%*s", width-len([]rune(a.Name)), "")
+		opts.IO.Printf("%s  %10s  %-8s  %-12s  %s\n", name, size, a.Host, added, cs.Gray(a.ID))
+	}
+
+	return nil
+}
@@ -0,0 +1,274 @@
+package blockers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+	"github.com/timwehrle/asana/pkg/format"
+	"github.com/timwehrle/asana/pkg/iostreams"
+)
+
+type BlockersOptions struct {
+	cmdutils.BaseOptions
+
+	Task string
+
+	now func() time.Time
+}
+
+// blockerFields are the task fields needed to walk and print the graph.
+var blockerFields = []string{"name", "completed", "due_on", "due_at", "assignee.name", "dependencies"}
+
+// node is an incomplete blocker in the dependency graph.
+type node struct {
+	Task     *asana.Task
+	Blockers []*node
+
+	// Cycle is set if the task is already on the path to it, so the
+	// dependencies form a cycle.
+	Cycle bool
+	// Seen is set if the task's blockers are shown elsewhere in the tree.
+	Seen bool
+}
+
+// graph is the result of walking the dependencies of a task.
+type graph struct {
+	Blockers []*node
+	Cycles   [][]*asana.Task
+	// Unique holds each incomplete blocker once.
+	Unique []*asana.Task
+}
+
+func NewCmdBlockers(f factory.Factory, runF func(*BlockersOptions) error) *cobra.Command {
+	opts := &BlockersOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:        f.IOStreams,
+			Prompter:  f.Prompter,
+			Config:    f.Config,
+			Client:    f.Client,
+			GitClient: f.GitClient,
+		},
+		now: time.Now,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "blockers [<task>]",
+		Short: "Show everything blocking a task",
+		Long: heredoc.Doc(`
+				Show the full chain of incomplete tasks blocking a task, following the
+				dependencies of its dependencies. Blockers that are overdue or unassigned
+				are highlighted, and dependency cycles are reported.
+			`),
+		Example: heredoc.Doc(`
+				$ asana tasks blockers 1204567890123
+				$ asana tasks blockers
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Task = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runBlockers(opts)
+		},
+	}
+
+	return cmd
+}
+
+func runBlockers(opts *BlockersOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	task, err := cmdutils.ResolveTask(&opts.BaseOptions, client, opts.Task, "Select a task to show the blockers of:")
+	if err != nil {
+		return err
+	}
+
+	fetch := func(id string) (*asana.Task, error) {
+		t := &asana.Task{ID: id}
+		if err := t.Fetch(client, &asana.Options{Fields: blockerFields}); err != nil {
+			return nil, fmt.Errorf("failed to fetch task %s: %w", id, err)
+		}
+		return t, nil
+	}
+
+	root, err := fetch(task.ID)
+	if err != nil {
+		return err
+	}
+
+	g, err := walk(root, fetch)
+	if err != nil {
+		return err
+	}
+
+	if len(g.Blockers) == 0 {
+		opts.IO.Printf("%s %s has no incomplete blockers\n", cs.SuccessIcon, cs.Bold(root.Name))
+		return nil
+	}
+
+	now := opts.now()
+	overdue, unassigned := 0, 0
+	for _, t := range g.Unique {
+		if cmdutils.IsOverdue(t, now) {
+			overdue++
+		}
+		if t.Assignee == nil {
+			unassigned++
+		}
+	}
+
+	opts.IO.Printf("%s is blocked by:\n", cs.Bold(root.Name))
+	printTree(opts.IO, g.Blockers, "", now)
+
+	opts.IO.Println()
+	summary := fmt.Sprintf("%d incomplete blocker(s)", len(g.Unique))
+	if overdue > 0 {
+		summary += ", " + cs.Error(fmt.Sprintf("%d overdue", overdue))
+	}
+	if unassigned > 0 {
+		summary += ", " + cs.Warning(fmt.Sprintf("%d unassigned", unassigned))
+	}
+	opts.IO.Println(summary)
+
+	for _, cycle := range g.Cycles {
+		names := format.MapToStrings(cycle, func(t *asana.Task) string {
+			return t.Name
+		})
+		opts.IO.Printf("%s Dependency cycle: %s\n", cs.WarningIcon, strings.Join(names, " → "))
+	}
+
+	return nil
+}
+
+// walk follows the incomplete dependencies of root transitively. Every task
+// is fetched once; tasks reached again are marked as seen, and dependencies
+// leading back onto the current path are reported as cycles.
+func walk(root *asana.Task, fetch func(id string) (*asana.Task, error)) (*graph, error) {
+	g := &graph{}
+	cache := map[string]*asana.Task{root.ID: root}
+	expanded := map[string]bool{}
+
+	get := func(id string) (*asana.Task, error) {
+		if t, ok := cache[id]; ok {
+			return t, nil
+		}
+		t, err := fetch(id)
+		if err != nil {
+			return nil, err
+		}
+		cache[id] = t
+		return t, nil
+	}
+
+	var visit func(t *asana.Task, path []*asana.Task) ([]*node, error)
+	visit = func(t *asana.Task, path []*asana.Task) ([]*node, error) {
+		var nodes []*node
+
+		for _, dep := range t.Dependencies {
+			if i := indexOf(path, dep.ID); i >= 0 {
+				cycle := append(append([]*asana.Task{}, path[i:]...), path[i])
+				g.Cycles = append(g.Cycles, cycle)
+				nodes = append(nodes, &node{Task: path[i], Cycle: true})
+				continue
+			}
+
+			d, err := get(dep.ID)
+			if err != nil {
+				return nil, err
+			}
+			if d.Completed != nil && *d.Completed {
+				continue
+			}
+
+			n := &node{Task: d}
+			if expanded[d.ID] {
+				n.Seen = true
+			} else {
+				expanded[d.ID] = true
+				g.Unique = append(g.Unique, d)
+				if n.Blockers, err = visit(d, append(path[:len(path):len(path)], d)); err != nil {
+					return nil, err
+				}
+			}
+			nodes = append(nodes, n)
+		}
+
+		return nodes, nil
+	}
+
+	blockers, err := visit(root, []*asana.Task{root})
+	if err != nil {
+		return nil, err
+	}
+	g.Blockers = blockers
+
+	return g, nil
+}
+
+func indexOf(path []*asana.Task, id string) int {
+	for i, t := range path {
+		if t.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func printTree(io *iostreams.IOStreams, nodes []*node, prefix string, now time.Time) {
+	cs := io.ColorScheme()
+
+	for i, n := range nodes {
+		branch, indent := "├── ", "│   "
+		if i == len(nodes)-1 {
+			branch, indent = "└── ", "    "
+		}
+
+		line := prefix + branch + "○ " + n.Task.Name
+		switch {
+		case n.Cycle:
+			line += " " + cs.Error("(cycle)")
+		case n.Seen:
+			line += cs.Gray(" (see above)")
+		default:
+			if n.Task.DueOn != nil || n.Task.DueAt != nil {
+				due := " · " + dueDate(n.Task)
+				if cmdutils.IsOverdue(n.Task, now) {
+					line += cs.Error(due + " overdue")
+				} else {
+					line += cs.Gray(due)
+				}
+			}
+			if n.Task.Assignee == nil {
+				line += " " + cs.Warning("unassigned")
+			} else {
+				line += cs.Gray(" @" + n.Task.Assignee.Name)
+			}
+		}
+		io.Println(line)
+
+		printTree(io, n.Blockers, prefix+indent, now)
+	}
+}
+
+func dueDate(t *asana.Task) string {
+	if t.DueAt != nil {
+		return t.DueAt.Local().Format("Jan 02, 2006 15:04")
+	}
+	return format.Date(t.DueOn)
+}
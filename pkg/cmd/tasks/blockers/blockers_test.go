@@ -0,0 +1,101 @@
+package blockers
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/internal/api/asana"
+)
+
+func task(id string, completed bool, deps ...string) *asana.Task {
+	t := &asana.Task{ID: id}
+	t.Name = "Task " + id
+	t.Completed = &completed
+	for _, dep := range deps {
+		t.Dependencies = append(t.Dependencies, &asana.Task{ID: dep})
+	}
+	return t
+}
+
+func fetcher(tasks ...*asana.Task) (func(string) (*asana.Task, error), map[string]int) {
+	byID := map[string]*asana.Task{}
+	for _, t := range tasks {
+		byID[t.ID] = t
+	}
+
+	calls := map[string]int{}
+	return func(id string) (*asana.Task, error) {
+		calls[id]++
+		t, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("task %s not found", id)
+		}
+		return t, nil
+	}, calls
+}
+
+func names(nodes []*node) []string {
+	var out []string
+	for _, n := range nodes {
+		out = append(out, n.Task.Name)
+	}
+	return out
+}
+
+func TestWalk(t *testing.T) {
+	t.Run("transitive chain skips completed", func(t *testing.T) {
+		root := task("1", false, "2", "3")
+		fetch, _ := fetcher(task("2", false, "4"), task("3", true, "5"), task("4", false))
+
+		g, err := walk(root, fetch)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"Task 2"}, names(g.Blockers))
+		assert.Equal(t, []string{"Task 4"}, names(g.Blockers[0].Blockers))
+		assert.Len(t, g.Unique, 2)
+		assert.Empty(t, g.Cycles)
+	})
+
+	t.Run("shared blocker fetched once", func(t *testing.T) {
+		root := task("1", false, "2", "3")
+		fetch, calls := fetcher(task("2", false, "4"), task("3", false, "4"), task("4", false))
+
+		g, err := walk(root, fetch)
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, calls["4"])
+		assert.Len(t, g.Unique, 3)
+		assert.False(t, g.Blockers[0].Blockers[0].Seen)
+		assert.True(t, g.Blockers[1].Blockers[0].Seen)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		root := task("1", false, "2")
+		fetch, _ := fetcher(task("2", false, "3"), task("3", false, "1"))
+
+		g, err := walk(root, fetch)
+		require.NoError(t, err)
+
+		require.Len(t, g.Cycles, 1)
+		assert.Equal(t, []string{"1", "2", "3", "1"}, ids(g.Cycles[0]))
+		assert.True(t, g.Blockers[0].Blockers[0].Blockers[0].Cycle)
+	})
+
+	t.Run("fetch error", func(t *testing.T) {
+		root := task("1", false, "2")
+		fetch, _ := fetcher()
+
+		_, err := walk(root, fetch)
+		require.EqualError(t, err, "task 2 not found")
+	})
+}
+
+func ids(tasks []*asana.Task) []string {
+	var out []string
+	for _, t := range tasks {
+		out = append(out, t.ID)
+	}
+	return out
+}
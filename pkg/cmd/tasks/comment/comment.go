@@ -0,0 +1,122 @@
+package comment
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/tasks/comment/delete"
+	"github.com/timwehrle/asana/pkg/cmd/tasks/comment/edit"
+	"github.com/timwehrle/asana/pkg/cmd/tasks/comment/pin"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type CommentOptions struct {
+	cmdutils.BaseOptions
+
+	Task     string
+	Message  string
+	Markdown *bool
+	Pin      bool
+}
+
+func NewCmdComment(f factory.Factory, runF func(*CommentOptions) error) *cobra.Command {
+	opts := &CommentOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:        f.IOStreams,
+			Prompter:  f.Prompter,
+			Config:    f.Config,
+			Client:    f.Client,
+			GitClient: f.GitClient,
+		},
+	}
+
+	var useMarkdown bool
+
+	cmd := &cobra.Command{
+		Use:   "comment [<task>]",
+		Short: "Add a comment to a task",
+		Long: heredoc.Doc(`
+				Add a comment to a task.
+
+				The comment is taken from --message, read from standard input, or written
+				in your editor. Mention people with @name or @[Full Name]; mentions are
+				turned into profile links.
+
+				Use the edit, delete and pin subcommands to change your own comments.
+			`),
+		Example: heredoc.Doc(`
+				$ asana tasks comment 1204567890123 -m "Deployed to staging, @jane please verify"
+				$ git log -1 --format=%B | asana tasks comment
+				$ asana tasks comment --markdown
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Task = args[0]
+			}
+			if cmd.Flags().Changed("markdown") {
+				opts.Markdown = &useMarkdown
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runComment(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Message, "message", "m", "", `Comment text, or "-" to read from standard input`)
+	cmd.Flags().BoolVar(&useMarkdown, "markdown", false, "Write the comment in Markdown")
+	cmd.Flags().BoolVar(&opts.Pin, "pin", false, "Pin the comment to the task")
+
+	cmd.AddCommand(edit.NewCmdEdit(f, nil))
+	cmd.AddCommand(delete.NewCmdDelete(f, nil))
+	cmd.AddCommand(pin.NewCmdPin(f, nil))
+
+	return cmd
+}
+
+func runComment(opts *CommentOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	task, err := cmdutils.ResolveTask(&opts.BaseOptions, client, opts.Task, "Select a task to comment on:")
+	if err != nil {
+		return err
+	}
+
+	text, err := cmdutils.ReadBody(&opts.BaseOptions, opts.Message, "Write your comment:", "")
+	if err != nil {
+		return err
+	}
+
+	htmlText, err := cmdutils.CommentHTML(client, cfg, text, cmdutils.UseMarkdown(opts.Markdown, cfg))
+	if err != nil {
+		return fmt.Errorf("failed to convert comment: %w", err)
+	}
+
+	story := &asana.StoryBase{HTMLText: htmlText}
+	if opts.Pin {
+		story.IsPinned = &opts.Pin
+	}
+
+	if _, err := task.CreateComment(client, story); err != nil {
+		return fmt.Errorf("failed to add comment: %w", err)
+	}
+
+	opts.IO.Printf("%s Commented on %s\n", cs.SuccessIcon, cs.Bold(task.Name))
+	return nil
+}
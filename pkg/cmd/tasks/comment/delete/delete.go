@@ -0,0 +1,92 @@
+package delete
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type DeleteOptions struct {
+	cmdutils.BaseOptions
+
+	Comment string
+	Task    string
+	Yes     bool
+}
+
+func NewCmdDelete(f factory.Factory, runF func(*DeleteOptions) error) *cobra.Command {
+	opts := &DeleteOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:        f.IOStreams,
+			Prompter:  f.Prompter,
+			Config:    f.Config,
+			Client:    f.Client,
+			GitClient: f.GitClient,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete [<comment-id>]",
+		Short: "Delete one of your comments",
+		Long: heredoc.Doc(`
+				Delete one of your comments. Without a comment ID, select one of your
+				comments on a task.
+			`),
+		Example: heredoc.Doc(`
+				$ asana tasks comment delete 1208765432101 --yes
+				$ asana tasks comment delete --task 1204567890123
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Comment = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runDelete(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Task, "task", "", "Task to select the comment from")
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Delete without asking for confirmation")
+
+	return cmd
+}
+
+func runDelete(opts *DeleteOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	story, err := cmdutils.ResolveComment(&opts.BaseOptions, client, opts.Comment, opts.Task, "Select a comment to delete:")
+	if err != nil {
+		return err
+	}
+
+	if !opts.Yes {
+		ok, err := opts.Prompter.Confirm("Delete this comment?", "No")
+		if err != nil {
+			return fmt.Errorf("failed to confirm: %w", err)
+		}
+		if !ok {
+			opts.IO.Printf("%s Comment kept\n", cs.WarningIcon)
+			return nil
+		}
+	}
+
+	if err := story.Delete(client); err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+
+	opts.IO.Printf("%s Comment deleted\n", cs.SuccessIcon)
+	return nil
+}
@@ -0,0 +1,119 @@
+package edit
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+	"github.com/timwehrle/asana/pkg/markdown"
+)
+
+type EditOptions struct {
+	cmdutils.BaseOptions
+
+	Comment  string
+	Task     string
+	Message  string
+	Markdown *bool
+}
+
+func NewCmdEdit(f factory.Factory, runF func(*EditOptions) error) *cobra.Command {
+	opts := &EditOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:        f.IOStreams,
+			Prompter:  f.Prompter,
+			Config:    f.Config,
+			Client:    f.Client,
+			GitClient: f.GitClient,
+		},
+	}
+
+	var useMarkdown bool
+
+	cmd := &cobra.Command{
+		Use:   "edit [<comment-id>]",
+		Short: "Edit one of your comments",
+		Long: heredoc.Doc(`
+				Edit one of your comments. Without a comment ID, select one of your
+				comments on a task.
+			`),
+		Example: heredoc.Doc(`
+				$ asana tasks comment edit 1208765432101 -m "Fixed in v2.1"
+				$ asana tasks comment edit --task 1204567890123
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Comment = args[0]
+			}
+			if cmd.Flags().Changed("markdown") {
+				opts.Markdown = &useMarkdown
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runEdit(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Task, "task", "", "Task to select the comment from")
+	cmd.Flags().StringVarP(&opts.Message, "message", "m", "", `New comment text, or "-" to read from standard input`)
+	cmd.Flags().BoolVar(&useMarkdown, "markdown", false, "Edit the comment as Markdown")
+
+	return cmd
+}
+
+func runEdit(opts *EditOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	story, err := cmdutils.ResolveComment(&opts.BaseOptions, client, opts.Comment, opts.Task, "Select a comment to edit:")
+	if err != nil {
+		return err
+	}
+
+	useMarkdown := cmdutils.UseMarkdown(opts.Markdown, cfg)
+
+	existing := story.Text
+	if useMarkdown && story.HTMLText != "" {
+		existing, err = markdown.FromHTML(story.HTMLText, cmdutils.MentionNames(client, cfg))
+		if err != nil {
+			return err
+		}
+	}
+
+	text, err := cmdutils.ReadBody(&opts.BaseOptions, opts.Message, "Edit your comment:", existing)
+	if err != nil {
+		return err
+	}
+	if text == existing {
+		opts.IO.Printf("%s No changes made to the comment\n", cs.WarningIcon)
+		return nil
+	}
+
+	htmlText, err := cmdutils.CommentHTML(client, cfg, text, useMarkdown)
+	if err != nil {
+		return fmt.Errorf("failed to convert comment: %w", err)
+	}
+
+	if _, err := story.UpdateStory(client, &asana.StoryBase{HTMLText: htmlText}); err != nil {
+		return fmt.Errorf("failed to update comment: %w", err)
+	}
+
+	opts.IO.Printf("%s Comment updated\n", cs.SuccessIcon)
+	return nil
+}
@@ -0,0 +1,87 @@
+package pin
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type PinOptions struct {
+	cmdutils.BaseOptions
+
+	Comment string
+	Task    string
+	Unpin   bool
+}
+
+func NewCmdPin(f factory.Factory, runF func(*PinOptions) error) *cobra.Command {
+	opts := &PinOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:        f.IOStreams,
+			Prompter:  f.Prompter,
+			Config:    f.Config,
+			Client:    f.Client,
+			GitClient: f.GitClient,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "pin [<comment-id>]",
+		Short: "Pin one of your comments to its task",
+		Long: heredoc.Doc(`
+				Pin one of your comments to the top of its task, or unpin it with --unpin.
+				Without a comment ID, select one of your comments on a task.
+			`),
+		Example: heredoc.Doc(`
+				$ asana tasks comment pin 1208765432101
+				$ asana tasks comment pin 1208765432101 --unpin
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Comment = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runPin(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Task, "task", "", "Task to select the comment from")
+	cmd.Flags().BoolVar(&opts.Unpin, "unpin", false, "Unpin the comment")
+
+	return cmd
+}
+
+func runPin(opts *PinOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	story, err := cmdutils.ResolveComment(&opts.BaseOptions, client, opts.Comment, opts.Task, "Select a comment to pin:")
+	if err != nil {
+		return err
+	}
+
+	pinned := !opts.Unpin
+	if _, err := story.UpdateStory(client, &asana.StoryBase{IsPinned: &pinned}); err != nil {
+		return fmt.Errorf("failed to update comment: %w", err)
+	}
+
+	if pinned {
+		opts.IO.Printf("%s Comment pinned\n", cs.SuccessIcon)
+	} else {
+		opts.IO.Printf("%s Comment unpinned\n", cs.SuccessIcon)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package comments
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+	"github.com/timwehrle/asana/pkg/format"
+	"github.com/timwehrle/asana/pkg/iostreams"
+)
+
+type CommentsOptions struct {
+	cmdutils.BaseOptions
+
+	Task string
+}
+
+func NewCmdComments(f factory.Factory, runF func(*CommentsOptions) error) *cobra.Command {
+	opts := &CommentsOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:        f.IOStreams,
+			Prompter:  f.Prompter,
+			Config:    f.Config,
+			Client:    f.Client,
+			GitClient: f.GitClient,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "comments [<task>]",
+		Short: "List the comments on a task",
+		Long: heredoc.Doc(`
+				List the comments on a task with their author, age and ID. Use the ID with
+				"asana tasks comment edit|delete|pin" to change your own comments.
+			`),
+		Example: heredoc.Doc(`
+				$ asana tasks comments
+				$ asana tasks comments 1204567890123
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Task = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runComments(opts)
+		},
+	}
+
+	return cmd
+}
+
+func runComments(opts *CommentsOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	task, err := cmdutils.ResolveTask(&opts.BaseOptions, client, opts.Task, "Select a task to view comments of:")
+	if err != nil {
+		return err
+	}
+
+	stories, err := cmdutils.AllStories(client, task, cmdutils.StoryFields...)
+	if err != nil {
+		return fmt.Errorf("failed to fetch comments: %w", err)
+	}
+
+	var comments []*asana.Story
+	for _, story := range stories {
+		if cmdutils.IsComment(story) {
+			comments = append(comments, story)
+		}
+	}
+
+	if len(comments) == 0 {
+		opts.IO.Printf("No comments on %s\n", cs.Bold(task.Name))
+		return nil
+	}
+
+	opts.IO.Printf("%s\n\n", cs.Bold(fmt.Sprintf("Comments on %s (%d)", task.Name, len(comments))))
+	for i, story := range comments {
+		if i > 0 {
+			opts.IO.Println()
+		}
+		printComment(opts.IO, story)
+	}
+
+	return nil
+}
+
+func printComment(io *iostreams.IOStreams, story *asana.Story) {
+	cs := io.ColorScheme()
+
+	author := "Unknown"
+	if story.CreatedBy != nil && story.CreatedBy.Name != "" {
+		author = story.CreatedBy.Name
+	}
+
+	header := cs.Bold(author)
+	if story.CreatedAt != nil {
+		header += cs.Gray(" · " + format.RelativeTime(*story.CreatedAt))
+	}
+	if story.IsEdited {
+		header += cs.Gray(" · edited")
+	}
+	if story.IsPinned != nil && *story.IsPinned {
+		header += " " + cs.Warning("pinned")
+	}
+	header += cs.Gray(" (" + story.ID + ")")
+
+	io.Println(header)
+	io.Println(format.IndentedRichText(story.HTMLText, story.Text, io, "  "))
+}
@@ -5,14 +5,17 @@ import (
 	"strings"
 	"time"
 
+	"github.com/MakeNowJust/heredoc"
 	"github.com/spf13/cobra"
 	"github.com/timwehrle/asana/internal/api/asana"
 	"github.com/timwehrle/asana/internal/config"
 	"github.com/timwehrle/asana/internal/prompter"
+	"github.com/timwehrle/asana/pkg/cmdutils"
 	"github.com/timwehrle/asana/pkg/convert"
 	"github.com/timwehrle/asana/pkg/factory"
 	"github.com/timwehrle/asana/pkg/format"
 	"github.com/timwehrle/asana/pkg/iostreams"
+	"github.com/timwehrle/asana/pkg/markdown"
 )
 
 type CreateOptions struct {
@@ -25,6 +28,11 @@ type CreateOptions struct {
 	Assignee    string
 	Due         string
 	Description string
+	Project     string
+	Section     string
+	Tags        []string
+	Fields      []string
+	Markdown    *bool
 }
 
 func NewCmdCreate(f factory.Factory, runF func(*CreateOptions) error) *cobra.Command {
@@ -35,11 +43,28 @@ func NewCmdCreate(f factory.Factory, runF func(*CreateOptions) error) *cobra.Com
 		Client:   f.Client,
 	}
 
+	var useMarkdown bool
+
 	cmd := &cobra.Command{
 		Use:   "create",
 		Short: "Create a new task",
-		Long:  "Create a new task in Asana.",
+		Long: heredoc.Docf(`
+				Create a new task in Asana.
+
+				Inside a directory linked with %[1]sasana link%[1]s, the linked project, section,
+				assignee and tags are used unless overridden by flags.
+
+				With --markdown, the description is written in Markdown and stored as rich
+				text. Set %[1]sasana config set markdown%[1]s to make this the default.
+
+				Custom fields of the project are set with --field, which takes the same
+				<name>=<value> form as %[1]sasana tasks set-field%[1]s and can be repeated.
+			`, "`"),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if cmd.Flags().Changed("markdown") {
+				opts.Markdown = &useMarkdown
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -51,6 +76,11 @@ func NewCmdCreate(f factory.Factory, runF func(*CreateOptions) error) *cobra.Com
 	cmd.Flags().StringVarP(&opts.Assignee, "assignee", "a", "", "Assignee name or 'me'")
 	cmd.Flags().StringVarP(&opts.Due, "due", "d", "", "Due date (YYYY-MM-DD, 'today', 'tomorrow')")
 	cmd.Flags().StringVarP(&opts.Description, "description", "m", "", "Task description")
+	cmd.Flags().StringVarP(&opts.Project, "project", "p", "", "Project name or ID (defaults to the linked project)")
+	cmd.Flags().StringVarP(&opts.Section, "section", "s", "", "Section name or ID (defaults to the linked section)")
+	cmd.Flags().StringSliceVarP(&opts.Tags, "tag", "t", nil, "Tag names or IDs (defaults to the linked tags)")
+	cmd.Flags().StringArrayVar(&opts.Fields, "field", nil, "Set a custom field as <name>=<value> (repeatable)")
+	cmd.Flags().BoolVar(&useMarkdown, "markdown", false, "Write the description in Markdown")
 
 	return cmd
 }
@@ -79,6 +109,16 @@ func runCreate(opts *CreateOptions) error {
 		return fmt.Errorf("task name cannot be empty")
 	}
 
+	// Fall back to the defaults of a linked directory
+	if cfg.Link != nil {
+		if opts.Assignee == "" {
+			opts.Assignee = cfg.Link.Assignee
+		}
+		if len(opts.Tags) == 0 {
+			opts.Tags = cfg.Link.Tags
+		}
+	}
+
 	// Get or prompt for assignee
 	assignee, err := getOrSelectAssignee(opts, cfg, client)
 	if err != nil {
@@ -102,23 +142,39 @@ func runCreate(opts *CreateOptions) error {
 		}
 	}
 
-	// Prompt for project
-	project, err := getProject(opts, cfg.Workspace.ID, client)
+	// Get or prompt for project
+	project, err := cmdutils.ResolveProject(client, cfg, opts.Prompter, opts.Project)
+	if err != nil {
+		return err
+	}
+
+	// Get or prompt for section
+	section, err := cmdutils.ResolveSection(client, cfg, opts.Prompter, project, opts.Section)
 	if err != nil {
 		return err
 	}
 
-	// Prompt for section
-	section, err := getSection(opts, project.ID, client)
+	tags, err := cmdutils.ResolveTags(client, cfg, opts.Tags)
 	if err != nil {
 		return err
 	}
 
+	var customFields map[string]any
+	if len(opts.Fields) > 0 {
+		fields, err := cmdutils.ProjectCustomFields(client, []*asana.Project{project})
+		if err != nil {
+			return err
+		}
+		customFields, err = cmdutils.CustomFieldValues(fields, opts.Fields, cmdutils.UserIDResolver(client, cfg))
+		if err != nil {
+			return err
+		}
+	}
+
 	req := &asana.CreateTaskRequest{
 		TaskBase: asana.TaskBase{
 			Name:  name,
 			DueOn: dueDate,
-			Notes: description,
 		},
 		Workspace: cfg.Workspace.ID,
 		Assignee:  assignee.ID,
@@ -133,13 +189,32 @@ func runCreate(opts *CreateOptions) error {
 				Section: section.ID,
 			},
 		},
+
+		Tags: format.MapToStrings(tags, func(t *asana.Tag) string {
+			return t.ID
+		}),
+		CustomFields: customFields,
+	}
+	if cmdutils.UseMarkdown(opts.Markdown, cfg) {
+		if description != "" {
+			req.HTMLNotes, err = markdown.ToHTML(description, cmdutils.MentionResolver(client, cfg))
+			if err != nil {
+				return fmt.Errorf("failed to convert description: %w", err)
+			}
+		}
+	} else {
+		req.Notes = description
 	}
+
 	if err := req.Validate(); err != nil {
 		return fmt.Errorf("task validation failed: %w", err)
 	}
 
 	task, err := client.CreateTask(req)
 	if err != nil {
+		if len(customFields) > 0 && asana.IsForbiddenError(err) {
+			return fmt.Errorf("error creating task: %w", cmdutils.ErrLockedField)
+		}
 		return fmt.Errorf("error creating task: %w", err)
 	}
 
@@ -227,23 +302,8 @@ func getOrPromptDueDate(opts *CreateOptions) (*asana.Date, error) {
 			return nil, fmt.Errorf("failed to read due date: %w", err)
 		}
 	}
-	if input == "" {
-		return nil, nil
-	}
 
-	now := time.Now()
-	switch strings.ToLower(input) {
-	case "today":
-		return convert.ToDate(now.Format(time.DateOnly), time.DateOnly)
-	case "tomorrow":
-		return convert.ToDate(now.AddDate(0, 0, 1).Format(time.DateOnly), time.DateOnly)
-	}
-
-	due, err := convert.ToDate(input, time.DateOnly)
-	if err != nil {
-		return nil, fmt.Errorf("invalid due date %q: %w", input, err)
-	}
-	return due, nil
+	return convert.ToDueDate(input, time.Now())
 }
 
 func addDescription(opts *CreateOptions) (string, error) {
@@ -254,39 +314,3 @@ func addDescription(opts *CreateOptions) (string, error) {
 
 	return strings.TrimSpace(description), nil
 }
-
-func getProject(opts *CreateOptions, workspaceID string, client *asana.Client) (*asana.Project, error) {
-	ws := &asana.Workspace{ID: workspaceID}
-	projects, err := ws.AllProjects(client)
-	if err != nil {
-		return nil, fmt.Errorf("cannot fetch projects: %w", err)
-	}
-
-	names := format.MapToStrings(projects, func(p *asana.Project) string {
-		return p.Name
-	})
-
-	selected, err := opts.Prompter.Select("Select project: ", names)
-	if err != nil {
-		return nil, fmt.Errorf("project selection failed: %w", err)
-	}
-	return projects[selected], nil
-}
-
-func getSection(opts *CreateOptions, projectID string, client *asana.Client) (*asana.Section, error) {
-	project := &asana.Project{ID: projectID}
-	sections, _, err := project.Sections(client)
-	if err != nil {
-		return nil, fmt.Errorf("cannot fetch sections: %w", err)
-	}
-
-	names := format.MapToStrings(sections, func(p *asana.Section) string {
-		return p.Name
-	})
-
-	selected, err := opts.Prompter.Select("Select section: ", names)
-	if err != nil {
-		return nil, fmt.Errorf("section selection failed: %w", err)
-	}
-	return sections[selected], nil
-}
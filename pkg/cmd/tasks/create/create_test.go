@@ -26,6 +26,8 @@ func TestNewCmdCreate_RunE(t *testing.T) {
 		"--assignee", "me",
 		"--due", "2025-01-01",
 		"--description", "Test description",
+		"--field", "Priority=High",
+		"--field", "Reviewers=alice,bob",
 	})
 
 	if err := cmd.Execute(); err != nil {
@@ -48,6 +50,9 @@ func TestNewCmdCreate_RunE(t *testing.T) {
 	if sawOpts.Description != "Test description" {
 		t.Errorf("Description = %q; want %q", sawOpts.Description, "Test description")
 	}
+	if strings.Join(sawOpts.Fields, "|") != "Priority=High|Reviewers=alice,bob" {
+		t.Errorf("Fields = %q; want %q", sawOpts.Fields, []string{"Priority=High", "Reviewers=alice,bob"})
+	}
 }
 
 func TestRunCreate_ConfigError(t *testing.T) {
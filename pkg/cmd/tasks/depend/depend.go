@@ -0,0 +1,113 @@
+package depend
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+	"github.com/timwehrle/asana/pkg/format"
+)
+
+// maxDependencies is the number of dependencies Asana allows per task.
+const maxDependencies = 15
+
+type DependOptions struct {
+	cmdutils.BaseOptions
+
+	Task string
+	On   []string
+}
+
+func NewCmdDepend(f factory.Factory, runF func(*DependOptions) error) *cobra.Command {
+	opts := &DependOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:        f.IOStreams,
+			Prompter:  f.Prompter,
+			Config:    f.Config,
+			Client:    f.Client,
+			GitClient: f.GitClient,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "depend [<task>] --on <task>...",
+		Short: "Mark a task as blocked by other tasks",
+		Long: heredoc.Doc(`
+				Mark a task as depending on, and so blocked by, one or more other tasks.
+				A task can depend on at most 15 tasks.
+			`),
+		Example: heredoc.Doc(`
+				$ asana tasks depend 1204567890123 --on 1204567890456
+				$ asana tasks depend --on 1204567890456,1204567890789
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Task = args[0]
+			}
+			if len(opts.On) > maxDependencies {
+				return fmt.Errorf("a task can depend on at most %d tasks", maxDependencies)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runDepend(opts)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&opts.On, "on", nil, "IDs or URLs of the tasks that block the task")
+	_ = cmd.MarkFlagRequired("on")
+
+	return cmd
+}
+
+func runDepend(opts *DependOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	task, err := cmdutils.ResolveTask(&opts.BaseOptions, client, opts.Task, "Select the blocked task:")
+	if err != nil {
+		return err
+	}
+
+	blockers, err := cmdutils.ResolveTasks(client, opts.On)
+	if err != nil {
+		return err
+	}
+	for _, b := range blockers {
+		if b.ID == task.ID {
+			return errors.New("a task cannot depend on itself")
+		}
+	}
+
+	if err := task.AddDependencies(client, &asana.AddDependenciesRequest{
+		Dependencies: ids(blockers),
+	}); err != nil {
+		return fmt.Errorf("failed to add dependencies: %w", err)
+	}
+
+	opts.IO.Printf("%s %s is now blocked by %s\n", cs.SuccessIcon, cs.Bold(task.Name), format.List("", names(blockers)))
+	return nil
+}
+
+func ids(tasks []*asana.Task) []string {
+	return format.MapToStrings(tasks, func(t *asana.Task) string {
+		return t.ID
+	})
+}
+
+func names(tasks []*asana.Task) []string {
+	return format.MapToStrings(tasks, func(t *asana.Task) string {
+		return t.Name
+	})
+}
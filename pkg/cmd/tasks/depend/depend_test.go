@@ -0,0 +1,128 @@
+package depend
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+	"github.com/timwehrle/asana/pkg/iostreams"
+)
+
+func TestNewCmdDepend(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		wantTask string
+		wantOn   []string
+		wantErr  string
+	}{
+		{
+			name:     "task and blockers",
+			args:     []string{"100", "--on", "200,300"},
+			wantTask: "100",
+			wantOn:   []string{"200", "300"},
+		},
+		{
+			name:   "repeated flag",
+			args:   []string{"--on", "200", "--on", "https://app.asana.com/0/1/300"},
+			wantOn: []string{"200", "https://app.asana.com/0/1/300"},
+		},
+		{
+			name:    "missing blockers",
+			args:    []string{"100"},
+			wantErr: `required flag(s) "on" not set`,
+		},
+		{
+			name:    "too many blockers",
+			args:    []string{"100", "--on", "1,2,3,4,5,6,7,8,9,10,11,12,13,14,15,16"},
+			wantErr: "a task can depend on at most 15 tasks",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, _, _ := factory.NewTestFactory()
+
+			var got *DependOptions
+			cmd := NewCmdDepend(f, func(opts *DependOptions) error {
+				got = opts
+				return nil
+			})
+			cmd.SetArgs(tt.args)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTask, got.Task)
+			assert.Equal(t, tt.wantOn, got.On)
+		})
+	}
+}
+
+func TestRunDepend(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://app.asana.com").
+		Get("/api/1.0/tasks/100").
+		Reply(200).
+		JSON(map[string]any{"data": map[string]any{"gid": "100", "name": "Ship"}})
+	gock.New("https://app.asana.com").
+		Get("/api/1.0/tasks/200").
+		Reply(200).
+		JSON(map[string]any{"data": map[string]any{"gid": "200", "name": "Review"}})
+	gock.New("https://app.asana.com").
+		Post("/api/1.0/tasks/100/addDependencies").
+		BodyString(`"dependencies":\["200"\]`).
+		Reply(200).
+		JSON(map[string]any{"data": map[string]any{}})
+
+	ios, _, stdout, _ := iostreams.Test()
+	opts := &DependOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO: ios,
+			Client: func() (*asana.Client, error) {
+				return asana.NewClient(http.DefaultClient), nil
+			},
+		},
+		Task: "100",
+		On:   []string{"200"},
+	}
+
+	require.NoError(t, runDepend(opts))
+	assert.True(t, gock.IsDone())
+	assert.Contains(t, stdout.String(), "Ship is now blocked by Review")
+}
+
+func TestRunDepend_Self(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://app.asana.com").
+		Get("/api/1.0/tasks/100").
+		Times(2).
+		Reply(200).
+		JSON(map[string]any{"data": map[string]any{"gid": "100", "name": "Ship"}})
+
+	ios, _, _, _ := iostreams.Test()
+	opts := &DependOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO: ios,
+			Client: func() (*asana.Client, error) {
+				return asana.NewClient(http.DefaultClient), nil
+			},
+		},
+		Task: "100",
+		On:   []string{"100"},
+	}
+
+	require.EqualError(t, runDepend(opts), "a task cannot depend on itself")
+}
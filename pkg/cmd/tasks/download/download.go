@@ -0,0 +1,305 @@
+package download
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+	"github.com/timwehrle/asana/pkg/format"
+	"github.com/timwehrle/asana/pkg/iostreams"
+)
+
+type DownloadOptions struct {
+	cmdutils.BaseOptions
+
+	Task    string
+	Names   []string
+	Dir     string
+	All     bool
+	Clobber bool
+}
+
+func NewCmdDownload(f factory.Factory, runF func(*DownloadOptions) error) *cobra.Command {
+	opts := &DownloadOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:        f.IOStreams,
+			Prompter:  f.Prompter,
+			Config:    f.Config,
+			Client:    f.Client,
+			GitClient: f.GitClient,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "download [<task>] [<name>|<id>...]",
+		Short: "Download the attachments of a task",
+		Long: heredoc.Doc(`
+				Download files attached to a task by name or ID, all of them with --all,
+				or one selected interactively.
+
+				Files are written to a temporary ".part" file first and only renamed once
+				complete, so an interrupted download resumes where it stopped the next
+				time. Files hosted outside Asana, such as on Google Drive or Dropbox,
+				cannot be downloaded and are skipped.
+			`),
+		Example: heredoc.Doc(`
+				$ asana tasks download 1204567890123 logo.svg mockup.png -o assets/
+				$ asana tasks download 1204567890123 --all
+				$ asana tasks download
+			`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Task = args[0]
+				opts.Names = args[1:]
+			}
+			if opts.All && len(opts.Names) > 0 {
+				return errors.New("specify attachments by name or use --all, not both")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runDownload(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Dir, "output", "o", ".", "Directory to save the files in")
+	cmd.Flags().BoolVarP(&opts.All, "all", "a", false, "Download all attachments")
+	cmd.Flags().BoolVar(&opts.Clobber, "clobber", false, "Overwrite existing files")
+
+	return cmd
+}
+
+func runDownload(opts *DownloadOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	task, err := cmdutils.ResolveTask(&opts.BaseOptions, client, opts.Task, "Select a task to download attachments of:")
+	if err != nil {
+		return err
+	}
+
+	attachments, err := cmdutils.AllAttachments(client, task)
+	if err != nil {
+		return fmt.Errorf("failed to fetch attachments: %w", err)
+	}
+	if len(attachments) == 0 {
+		return fmt.Errorf("no attachments on %q", task.Name)
+	}
+
+	selected, err := selectAttachments(opts, attachments)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(opts.Dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	used := make(map[string]bool)
+	failed := 0
+
+	for _, a := range selected {
+		// Download URLs expire after an hour, so get a fresh one for every file.
+		if err := a.Fetch(client, &asana.Options{Fields: []string{
+			"name", "host", "size", "download_url", "view_url",
+		}}); err != nil {
+			return fmt.Errorf("failed to fetch attachment %q: %w", a.Name, err)
+		}
+
+		if a.DownloadURL == "" {
+			opts.IO.ErrPrintf("%s Skipping %s: files hosted on %s can't be downloaded", cs.WarningIcon, a.Name, a.Host)
+			if a.ViewURL != "" {
+				opts.IO.ErrPrintf(", open %s instead", a.ViewURL)
+			}
+			opts.IO.ErrPrintln()
+			continue
+		}
+
+		path := filepath.Join(opts.Dir, fileName(a, used))
+		if _, err := os.Stat(path); err == nil && !opts.Clobber {
+			opts.IO.ErrPrintf("%s Skipping %s: %s already exists (use --clobber to overwrite)\n", cs.WarningIcon, a.Name, path)
+			continue
+		}
+
+		p := newProgress(opts.IO, a.Name)
+		size, err := download(client, a, path, p.update)
+		p.done()
+		if err != nil {
+			opts.IO.ErrPrintf("%s Failed to download %s: %s\n", cs.ErrorIcon, a.Name, err)
+			failed++
+			continue
+		}
+
+		opts.IO.Printf("%s Downloaded %s %s\n", cs.SuccessIcon, path, cs.Gray("("+format.Bytes(int(size))+")"))
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d downloads failed; run the command again to resume", failed, len(selected))
+	}
+	return nil
+}
+
+// selectAttachments returns the attachments matching the names or IDs given
+// on the command line, all of them with --all, or one chosen by the user.
+func selectAttachments(opts *DownloadOptions, attachments []*asana.Attachment) ([]*asana.Attachment, error) {
+	if opts.All {
+		return attachments, nil
+	}
+
+	if len(opts.Names) > 0 {
+		var selected []*asana.Attachment
+		for _, name := range opts.Names {
+			found := false
+			for _, a := range attachments {
+				if a.ID == name || strings.EqualFold(a.Name, name) {
+					selected = append(selected, a)
+					found = true
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("attachment %q not found", name)
+			}
+		}
+		return selected, nil
+	}
+
+	labels := format.MapToStrings(attachments, func(a *asana.Attachment) string {
+		if a.Size != nil {
+			return fmt.Sprintf("%s (%s)", a.Name, format.Bytes(*a.Size))
+		}
+		return fmt.Sprintf("%s (%s)", a.Name, a.Host)
+	})
+
+	index, err := opts.Prompter.Select("Select an attachment to download:", labels)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select attachment: %w", err)
+	}
+
+	return []*asana.Attachment{attachments[index]}, nil
+}
+
+// fileName returns a safe local file name for an attachment. Names used
+// earlier in the same download get the attachment ID appended.
+func fileName(a *asana.Attachment, used map[string]bool) string {
+	name := filepath.Base(filepath.FromSlash(strings.ReplaceAll(a.Name, `\`, "/")))
+	if name == "" || name == "." || name == ".." || name == string(filepath.Separator) {
+		name = a.ID
+	}
+
+	if used[name] {
+		ext := filepath.Ext(name)
+		name = strings.TrimSuffix(name, ext) + "-" + a.ID + ext
+	}
+	used[name] = true
+
+	return name
+}
+
+// download writes an attachment to path through a ".part" file, resuming a
+// previous partial download if one exists. It returns the size of the file.
+func download(client *asana.Client, a *asana.Attachment, path string, onProgress func(done, total int64)) (int64, error) {
+	part := path + ".part"
+
+	var offset int64
+	if info, err := os.Stat(part); err == nil {
+		offset = info.Size()
+	}
+
+	content, err := a.Download(client, offset)
+	if err != nil {
+		return 0, err
+	}
+	defer content.Close()
+
+	flags := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	if content.Offset > 0 {
+		flags = os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	}
+
+	f, err := os.OpenFile(part, flags, 0o644)
+	if err != nil {
+		return 0, err
+	}
+
+	w := &progressWriter{w: f, done: content.Offset, total: content.Size, onProgress: onProgress}
+	_, copyErr := io.Copy(w, content)
+	closeErr := f.Close()
+
+	if copyErr != nil {
+		return 0, copyErr
+	}
+	if closeErr != nil {
+		return 0, closeErr
+	}
+	if content.Size >= 0 && w.done != content.Size {
+		return 0, fmt.Errorf("incomplete download: received %d of %d bytes", w.done, content.Size)
+	}
+
+	if err := os.Rename(part, path); err != nil {
+		return 0, err
+	}
+	return w.done, nil
+}
+
+type progressWriter struct {
+	w          io.Writer
+	done       int64
+	total      int64
+	onProgress func(done, total int64)
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	p.done += int64(n)
+	if p.onProgress != nil {
+		p.onProgress(p.done, p.total)
+	}
+	return n, err
+}
+
+// progress shows the progress of a download on stderr when it is a terminal.
+type progress struct {
+	io    *iostreams.IOStreams
+	name  string
+	last  time.Time
+	shown bool
+}
+
+func newProgress(io *iostreams.IOStreams, name string) *progress {
+	return &progress{io: io, name: name}
+}
+
+func (p *progress) update(done, total int64) {
+	if !p.io.IsStderrTTY || time.Since(p.last) < 100*time.Millisecond {
+		return
+	}
+	p.last = time.Now()
+	p.shown = true
+
+	status := format.Bytes(int(done))
+	if total > 0 {
+		status = fmt.Sprintf("%s / %s (%d%%)", status, format.Bytes(int(total)), done*100/total)
+	}
+	p.io.ErrPrintf("\r\033[KDownloading %s  %s", p.name, status)
+}
+
+func (p *progress) done() {
+	if p.shown {
+		p.io.ErrPrintf("\r\033[K")
+	}
+}
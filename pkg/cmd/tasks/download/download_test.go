@@ -0,0 +1,104 @@
+package download
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/internal/api/asana"
+)
+
+func fileServer(t *testing.T, content []byte, ranges bool) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		if !ranges {
+			r.Header.Del("Range")
+		}
+		http.ServeContent(w, r, "file", time.Time{}, bytes.NewReader(content))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestDownload(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789"), 1000)
+	client := asana.NewClient(http.DefaultClient)
+
+	tests := []struct {
+		name    string
+		partial []byte
+		ranges  bool
+	}{
+		{name: "fresh download", ranges: true},
+		{name: "resumes partial download", partial: content[:4321], ranges: true},
+		{name: "restarts when the host ignores ranges", partial: []byte("stale data"), ranges: false},
+		{name: "restarts when the partial file is too large", partial: append(bytes.Clone(content), 'x'), ranges: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := fileServer(t, content, tt.ranges)
+			path := filepath.Join(t.TempDir(), "design.fig")
+			if tt.partial != nil {
+				require.NoError(t, os.WriteFile(path+".part", tt.partial, 0o644))
+			}
+
+			var lastDone, lastTotal int64
+			size, err := download(client, &asana.Attachment{ID: "1", DownloadURL: srv.URL}, path, func(done, total int64) {
+				lastDone, lastTotal = done, total
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, int64(len(content)), size)
+			assert.Equal(t, int64(len(content)), lastDone)
+			assert.Equal(t, int64(len(content)), lastTotal)
+
+			got, err := os.ReadFile(path)
+			require.NoError(t, err)
+			assert.Equal(t, content, got)
+
+			_, err = os.Stat(path + ".part")
+			assert.True(t, os.IsNotExist(err))
+		})
+	}
+}
+
+func TestSelectAttachments(t *testing.T) {
+	attachments := []*asana.Attachment{
+		{ID: "1", Name: "logo.svg"},
+		{ID: "2", Name: "Mockup.png"},
+		{ID: "3", Name: "logo.svg"},
+	}
+
+	selected, err := selectAttachments(&DownloadOptions{Names: []string{"mockup.png", "1"}}, attachments)
+	require.NoError(t, err)
+	assert.Equal(t, []*asana.Attachment{attachments[1], attachments[0]}, selected)
+
+	selected, err = selectAttachments(&DownloadOptions{Names: []string{"logo.svg"}}, attachments)
+	require.NoError(t, err)
+	assert.Equal(t, []*asana.Attachment{attachments[0], attachments[2]}, selected)
+
+	_, err = selectAttachments(&DownloadOptions{Names: []string{"missing.txt"}}, attachments)
+	assert.EqualError(t, err, `attachment "missing.txt" not found`)
+
+	selected, err = selectAttachments(&DownloadOptions{All: true}, attachments)
+	require.NoError(t, err)
+	assert.Equal(t, attachments, selected)
+}
+
+func TestFileName(t *testing.T) {
+	used := make(map[string]bool)
+
+	assert.Equal(t, "logo.svg", fileName(&asana.Attachment{ID: "1", Name: "logo.svg"}, used))
+	assert.Equal(t, "logo-2.svg", fileName(&asana.Attachment{ID: "2", Name: "logo.svg"}, used))
+	assert.Equal(t, "passwd", fileName(&asana.Attachment{ID: "3", Name: "../../etc/passwd"}, used))
+	assert.Equal(t, "evil.exe", fileName(&asana.Attachment{ID: "4", Name: `..\..\evil.exe`}, used))
+	assert.Equal(t, "5", fileName(&asana.Attachment{ID: "5", Name: ".."}, used))
+}
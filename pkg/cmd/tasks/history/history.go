@@ -0,0 +1,452 @@
+package history
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/convert"
+	"github.com/timwehrle/asana/pkg/factory"
+	"github.com/timwehrle/asana/pkg/format"
+	"github.com/timwehrle/asana/pkg/iostreams"
+)
+
+type HistoryOptions struct {
+	cmdutils.BaseOptions
+
+	Task   string
+	Types  []string
+	Actors []string
+	Since  string
+	JSON   bool
+
+	now func() time.Time
+}
+
+// storyFields are the story fields needed to describe every kind of event.
+var storyFields = []string{
+	"created_at",
+	"created_by.name",
+	"created_by.email",
+	"resource_subtype",
+	"text",
+	"is_edited",
+	"old_name", "new_name",
+	"old_dates", "new_dates",
+	"old_section.name", "new_section.name",
+	"assignee.name",
+	"follower.name",
+	"project.name",
+	"tag.name",
+	"task.name",
+	"dependency.name",
+	"duplicate_of.name",
+	"duplicated_from.name",
+	"old_resource_subtype", "new_resource_subtype",
+	"custom_field.name",
+	"custom_field.format", "custom_field.precision", "custom_field.currency_code",
+	"custom_field.custom_label", "custom_field.custom_label_position",
+	"old_text_value", "new_text_value",
+	"old_number_value", "new_number_value",
+	"old_enum_value.name", "new_enum_value.name",
+	"old_multi_enum_values.name", "new_multi_enum_values.name",
+	"old_date_value", "new_date_value",
+	"old_people_value.name", "new_people_value.name",
+}
+
+func NewCmdHistory(f factory.Factory, runF func(*HistoryOptions) error) *cobra.Command {
+	opts := &HistoryOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:        f.IOStreams,
+			Prompter:  f.Prompter,
+			Config:    f.Config,
+			Client:    f.Client,
+			GitClient: f.GitClient,
+		},
+		now: time.Now,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "history [<task>]",
+		Short: "Show the activity timeline of a task",
+		Long: heredoc.Doc(`
+				Show a chronological timeline of everything that happened to a task:
+				assignments, due date and section changes, renames, completions,
+				dependency and custom field changes, comments and more.
+
+				Filter events with --type, which matches the event type or any part of
+				it (e.g. "assigned", "due_date", "dependency", "custom_field" or
+				"comment"), and with --actor, which matches a user's name, email, ID or
+				"me". Both flags can be repeated or given comma-separated values.
+			`),
+		Example: heredoc.Doc(`
+				$ asana tasks history 1204567890123
+				$ asana tasks history --type section_changed --since 2w
+				$ asana tasks history --actor jane --actor me --since 2025-01-01
+				$ asana tasks history --type dependency,due_date --json
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Task = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runHistory(opts)
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(&opts.Types, "type", "t", nil, "Only show events of these types")
+	cmd.Flags().StringSliceVarP(&opts.Actors, "actor", "a", nil, "Only show events by these users")
+	cmd.Flags().StringVar(&opts.Since, "since", "", "Only show events after a date (YYYY-MM-DD) or duration ago (e.g. 12h, 7d, 2w)")
+	cmd.Flags().BoolVar(&opts.JSON, "json", false, "Output as JSON")
+
+	return cmd
+}
+
+func runHistory(opts *HistoryOptions) error {
+	var since time.Time
+	if opts.Since != "" {
+		t, err := convert.ToSince(opts.Since, opts.now())
+		if err != nil {
+			return err
+		}
+		since = t
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	task, err := cmdutils.ResolveTask(&opts.BaseOptions, client, opts.Task, "Select a task to show the history of:")
+	if err != nil {
+		return err
+	}
+
+	actors := opts.Actors
+	for i, actor := range actors {
+		if strings.EqualFold(actor, "me") {
+			me, err := cmdutils.CurrentUserID(client, cfg)
+			if err != nil {
+				return err
+			}
+			actors[i] = me
+		}
+	}
+
+	stories, err := cmdutils.AllStories(client, task, storyFields...)
+	if err != nil {
+		return fmt.Errorf("failed to fetch history: %w", err)
+	}
+
+	events := filter(stories, opts.Types, actors, since)
+
+	if opts.JSON {
+		if events == nil {
+			events = []*asana.Story{}
+		}
+		enc := json.NewEncoder(opts.IO.Out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(events)
+	}
+
+	cs := opts.IO.ColorScheme()
+	if len(events) == 0 {
+		opts.IO.Printf("No matching events on %s\n", cs.Bold(task.Name))
+		return nil
+	}
+
+	opts.IO.Printf("%s\n\n", cs.Bold(fmt.Sprintf("History of %s", task.Name)))
+	printTimeline(opts.IO, events)
+
+	return nil
+}
+
+// filter returns the stories matching all given filters, oldest first.
+func filter(stories []*asana.Story, types, actors []string, since time.Time) []*asana.Story {
+	var out []*asana.Story
+
+	for _, s := range stories {
+		if !since.IsZero() && (s.CreatedAt == nil || s.CreatedAt.Before(since)) {
+			continue
+		}
+		if len(types) > 0 && !matchesAny(types, func(t string) bool { return matchesType(s.ResourceSubtype, t) }) {
+			continue
+		}
+		if len(actors) > 0 && !matchesAny(actors, func(a string) bool { return matchesActor(s.CreatedBy, a) }) {
+			continue
+		}
+		out = append(out, s)
+	}
+
+	return out
+}
+
+func matchesAny(values []string, match func(string) bool) bool {
+	for _, v := range values {
+		if match(strings.TrimSpace(v)) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesType reports whether subtype is t or contains t as whole words, so
+// "dependency" matches "dependency_added" but "pend" does not.
+func matchesType(subtype, t string) bool {
+	t = strings.ToLower(strings.ReplaceAll(t, "-", "_"))
+	if t == "" {
+		return false
+	}
+	return strings.Contains("_"+subtype+"_", "_"+t+"_")
+}
+
+func matchesActor(user *asana.User, actor string) bool {
+	if user == nil || actor == "" {
+		return false
+	}
+	if user.ID == actor || strings.EqualFold(user.Email, actor) {
+		return true
+	}
+	return strings.Contains(strings.ToLower(user.Name), strings.ToLower(actor))
+}
+
+func printTimeline(io *iostreams.IOStreams, events []*asana.Story) {
+	cs := io.ColorScheme()
+
+	for _, s := range events {
+		when := ""
+		if s.CreatedAt != nil {
+			when = s.CreatedAt.Local().Format("Jan 02, 2006 15:04")
+		}
+
+		summary, changes := describe(s)
+		io.Printf("%s  %s %s\n", cs.Gray(fmt.Sprintf("%-18s", when)), cs.Bold(actor(s)), summary)
+
+		for _, c := range changes {
+			switch c.op {
+			case '-':
+				io.Printf("%20s%s\n", "", cs.Error("- "+c.text))
+			case '+':
+				io.Printf("%20s%s\n", "", cs.Success("+ "+c.text))
+			default:
+				io.Println(format.Indent(c.text, strings.Repeat(" ", 22)))
+			}
+		}
+	}
+}
+
+// change is a line shown below an event: a removed ('-') or added ('+')
+// value, or context (' ') such as the text of a comment.
+type change struct {
+	op   byte
+	text string
+}
+
+func diff(before, after string) []change {
+	return []change{{'-', before}, {'+', after}}
+}
+
+// describe summarizes a story as a sentence following the actor's name,
+// together with the values it changed.
+func describe(s *asana.Story) (string, []change) {
+	switch s.ResourceSubtype {
+	case "comment_added":
+		return "commented", []change{{' ', strings.TrimSpace(s.Text)}}
+	case "assigned":
+		if s.Assignee != nil {
+			return "assigned the task to " + s.Assignee.Name, nil
+		}
+	case "unassigned":
+		return "unassigned the task", nil
+	case "marked_complete":
+		return "marked the task complete", nil
+	case "marked_incomplete":
+		return "marked the task incomplete", nil
+	case "name_changed":
+		return "renamed the task", diff(s.OldName, s.NewName)
+	case "notes_changed":
+		return "changed the description", nil
+	case "due_date_changed":
+		return "changed the due date", diff(dates(s.OldDates), dates(s.NewDates))
+	case "section_changed":
+		return fmt.Sprintf("moved the task from %s to %s", sectionName(s.OldSection), sectionName(s.NewSection)), nil
+	case "added_to_project":
+		if s.Project != nil {
+			return "added the task to " + s.Project.Name, nil
+		}
+	case "removed_from_project":
+		if s.Project != nil {
+			return "removed the task from " + s.Project.Name, nil
+		}
+	case "added_to_tag":
+		if s.Tag != nil {
+			return "tagged the task with " + s.Tag.Name, nil
+		}
+	case "removed_from_tag":
+		if s.Tag != nil {
+			return "removed the tag " + s.Tag.Name, nil
+		}
+	case "added_to_task":
+		if s.Task != nil {
+			return "made the task a subtask of " + s.Task.Name, nil
+		}
+	case "removed_from_task":
+		if s.Task != nil {
+			return "removed the task from its parent " + s.Task.Name, nil
+		}
+	case "follower_added":
+		if s.Follower != nil {
+			return "added " + s.Follower.Name + " as a collaborator", nil
+		}
+	case "dependency_added":
+		if s.Dependency != nil {
+			return "marked the task as blocked by " + s.Dependency.Name, nil
+		}
+	case "dependency_removed":
+		if s.Dependency != nil {
+			return "removed the dependency on " + s.Dependency.Name, nil
+		}
+	case "dependent_added":
+		if s.Dependency != nil {
+			return "marked the task as blocking " + s.Dependency.Name, nil
+		}
+	case "dependent_removed":
+		if s.Dependency != nil {
+			return "removed the dependent " + s.Dependency.Name, nil
+		}
+	case "dependency_marked_complete":
+		if s.Dependency != nil {
+			return "completed the blocking task " + s.Dependency.Name, nil
+		}
+	case "dependency_marked_incomplete":
+		if s.Dependency != nil {
+			return "reopened the blocking task " + s.Dependency.Name, nil
+		}
+	case "dependency_due_date_changed":
+		if s.Dependency != nil {
+			return "changed the due date of the blocking task " + s.Dependency.Name, []change{{'+', dates(s.NewDates)}}
+		}
+	case "marked_duplicate":
+		if s.DuplicateOf != nil {
+			return "marked the task as a duplicate of " + s.DuplicateOf.Name, nil
+		}
+	case "duplicated":
+		if s.DuplicatedFrom != nil {
+			return "duplicated the task from " + s.DuplicatedFrom.Name, nil
+		}
+	case "resource_subtype_changed":
+		return fmt.Sprintf("changed the task type from %s to %s", s.OldResourceSubtype, s.NewResourceSubtype), nil
+	}
+
+	if strings.HasSuffix(s.ResourceSubtype, "_custom_field_changed") && s.CustomField != nil {
+		before, after := fieldValues(s)
+		return "changed " + s.CustomField.Name, diff(before, after)
+	}
+
+	return s.Text, nil
+}
+
+func fieldValues(s *asana.Story) (string, string) {
+	enums := func(values []*asana.EnumValue) string {
+		return strings.Join(format.MapToStrings(values, func(e *asana.EnumValue) string {
+			return e.Name
+		}), ", ")
+	}
+	people := func(users []*asana.User) string {
+		return strings.Join(format.MapToStrings(users, func(u *asana.User) string {
+			return u.Name
+		}), ", ")
+	}
+
+	var before, after string
+	switch strings.TrimSuffix(s.ResourceSubtype, "_custom_field_changed") {
+	case "text":
+		before, after = s.OldTextValue, s.NewTextValue
+	case "number":
+		before, after = numberValue(s.CustomField, s.OldNumberValue), numberValue(s.CustomField, s.NewNumberValue)
+	case "enum":
+		before, after = enumName(s.OldEnumValue), enumName(s.NewEnumValue)
+	case "multi_enum":
+		before, after = enums(s.OldMultiEnumValues), enums(s.NewMultiEnumValues)
+	case "date":
+		before, after = dateValue(s.OldDateValue), dateValue(s.NewDateValue)
+	case "people":
+		before, after = people(s.OldPeopleValue), people(s.NewPeopleValue)
+	}
+
+	return none(before), none(after)
+}
+
+func numberValue(f *asana.CustomField, v *float64) string {
+	if v == nil {
+		return ""
+	}
+	return format.Number(f, *v)
+}
+
+func dates(d *asana.Dates) string {
+	switch {
+	case d == nil:
+		return "None"
+	case d.DueAt != nil:
+		return d.DueAt.Local().Format("Jan 02, 2006 15:04")
+	case d.DueOn != nil:
+		return time.Time(*d.DueOn).Format("Jan 02, 2006")
+	}
+	return "None"
+}
+
+func dateValue(d *asana.DateValue) string {
+	switch {
+	case d == nil:
+		return ""
+	case d.DateTime != nil:
+		return d.DateTime.Local().Format("Jan 02, 2006 15:04")
+	case d.Date != nil:
+		return time.Time(*d.Date).Format("Jan 02, 2006")
+	}
+	return ""
+}
+
+func sectionName(s *asana.Section) string {
+	if s == nil {
+		return "None"
+	}
+	return none(s.Name)
+}
+
+func enumName(e *asana.EnumValue) string {
+	if e == nil {
+		return "None"
+	}
+	return none(e.Name)
+}
+
+func none(s string) string {
+	if s == "" {
+		return "None"
+	}
+	return s
+}
+
+func actor(s *asana.Story) string {
+	if s.CreatedBy == nil || s.CreatedBy.Name == "" {
+		return "Asana"
+	}
+	return s.CreatedBy.Name
+}
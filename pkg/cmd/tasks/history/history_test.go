@@ -0,0 +1,134 @@
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/timwehrle/asana/internal/api/asana"
+)
+
+func story(subtype string, by *asana.User, at time.Time) *asana.Story {
+	return &asana.Story{ResourceSubtype: subtype, CreatedBy: by, CreatedAt: &at}
+}
+
+func TestFilter(t *testing.T) {
+	jane := &asana.User{ID: "1", Name: "Jane Doe", Email: "jane@example.com"}
+	john := &asana.User{ID: "2", Name: "John Smith"}
+	day := func(d int) time.Time { return time.Date(2025, 3, d, 9, 0, 0, 0, time.UTC) }
+
+	stories := []*asana.Story{
+		story("assigned", jane, day(1)),
+		story("dependency_added", john, day(2)),
+		story("due_date_changed", jane, day(3)),
+		story("comment_added", john, day(4)),
+	}
+
+	tests := []struct {
+		name   string
+		types  []string
+		actors []string
+		since  time.Time
+		want   []string
+	}{
+		{name: "no filters", want: []string{"assigned", "dependency_added", "due_date_changed", "comment_added"}},
+		{name: "type by word", types: []string{"dependency"}, want: []string{"dependency_added"}},
+		{name: "partial words do not match", types: []string{"pend"}},
+		{name: "several types", types: []string{"due-date", "comment"}, want: []string{"due_date_changed", "comment_added"}},
+		{name: "actor by name", actors: []string{"jane"}, want: []string{"assigned", "due_date_changed"}},
+		{name: "actor by email or id", actors: []string{"JANE@example.com", "2"}, want: []string{"assigned", "dependency_added", "due_date_changed", "comment_added"}},
+		{name: "since", since: day(3), want: []string{"due_date_changed", "comment_added"}},
+		{name: "combined", types: []string{"comment", "assigned"}, actors: []string{"john"}, want: []string{"comment_added"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, s := range filter(stories, tt.types, tt.actors, tt.since) {
+				got = append(got, s.ResourceSubtype)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	due := asana.Date(time.Date(2025, 3, 20, 0, 0, 0, 0, time.UTC))
+	precision := 1
+	number := &asana.CustomField{}
+	number.Name = "Estimate"
+	number.Precision = &precision
+	progress := &asana.CustomField{}
+	progress.Name = "Progress"
+	progress.Format = asana.Percentage
+	value := func(v float64) *float64 { return &v }
+
+	tests := []struct {
+		name        string
+		story       *asana.Story
+		wantSummary string
+		wantChanges []change
+	}{
+		{
+			name: "renamed",
+			story: &asana.Story{ResourceSubtype: "name_changed", StorySubtypeFields: asana.StorySubtypeFields{
+				OldName: "Fix bug", NewName: "Fix login bug",
+			}},
+			wantSummary: "renamed the task",
+			wantChanges: []change{{'-', "Fix bug"}, {'+', "Fix login bug"}},
+		},
+		{
+			name: "due date set",
+			story: &asana.Story{ResourceSubtype: "due_date_changed", StorySubtypeFields: asana.StorySubtypeFields{
+				NewDates: &asana.Dates{DueOn: &due},
+			}},
+			wantSummary: "changed the due date",
+			wantChanges: []change{{'-', "None"}, {'+', "Mar 20, 2025"}},
+		},
+		{
+			name: "section changed",
+			story: &asana.Story{ResourceSubtype: "section_changed", StorySubtypeFields: asana.StorySubtypeFields{
+				OldSection: &asana.Section{SectionBase: asana.SectionBase{Name: "Backlog"}},
+				NewSection: &asana.Section{SectionBase: asana.SectionBase{Name: "In Progress"}},
+			}},
+			wantSummary: "moved the task from Backlog to In Progress",
+		},
+		{
+			name: "number field",
+			story: &asana.Story{ResourceSubtype: "number_custom_field_changed", StorySubtypeFields: asana.StorySubtypeFields{
+				CustomField: number, OldNumberValue: value(3), NewNumberValue: value(5.5),
+			}},
+			wantSummary: "changed Estimate",
+			wantChanges: []change{{'-', "3.0"}, {'+', "5.5"}},
+		},
+		{
+			name: "number field set",
+			story: &asana.Story{ResourceSubtype: "number_custom_field_changed", StorySubtypeFields: asana.StorySubtypeFields{
+				CustomField: number, NewNumberValue: value(0),
+			}},
+			wantSummary: "changed Estimate",
+			wantChanges: []change{{'-', "None"}, {'+', "0.0"}},
+		},
+		{
+			name: "percentage field",
+			story: &asana.Story{ResourceSubtype: "number_custom_field_changed", StorySubtypeFields: asana.StorySubtypeFields{
+				CustomField: progress, OldNumberValue: value(0.25), NewNumberValue: value(0.5),
+			}},
+			wantSummary: "changed Progress",
+			wantChanges: []change{{'-', "25%"}, {'+', "50%"}},
+		},
+		{
+			name:        "unknown subtype falls back to text",
+			story:       &asana.Story{ResourceSubtype: "attachment_added", StoryBase: asana.StoryBase{Text: "attached spec.pdf"}},
+			wantSummary: "attached spec.pdf",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary, changes := describe(tt.story)
+			assert.Equal(t, tt.wantSummary, summary)
+			assert.Equal(t, tt.wantChanges, changes)
+		})
+	}
+}
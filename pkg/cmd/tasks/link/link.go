@@ -0,0 +1,207 @@
+package link
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/internal/git"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type LinkOptions struct {
+	cmdutils.BaseOptions
+
+	Task          string
+	URL           string
+	Name          string
+	FromGitRemote bool
+	PullRequest   int
+}
+
+func NewCmdLink(f factory.Factory, runF func(*LinkOptions) error) *cobra.Command {
+	opts := &LinkOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:        f.IOStreams,
+			Prompter:  f.Prompter,
+			Config:    f.Config,
+			Client:    f.Client,
+			GitClient: f.GitClient,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "link [<task>] <url>",
+		Short: "Attach a link to a task",
+		Long: heredoc.Doc(`
+				Attach a URL, such as a pull request, document or dashboard, to a task.
+				Links that are already attached to the task are not added again.
+
+				With --from-git-remote, the link points to the current branch on the
+				repository's hosting service (GitHub, GitLab or Bitbucket), or to a pull
+				request with --pr. The task defaults to the one encoded in the branch name.
+			`),
+		Example: heredoc.Doc(`
+				$ asana tasks link 1204567890123 https://example.com/dashboard --name "Error dashboard"
+				$ asana tasks link https://docs.example.com/spec
+				$ asana tasks link --from-git-remote
+				$ asana tasks link --from-git-remote --pr 42
+			`),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if opts.FromGitRemote {
+				return cobra.MaximumNArgs(1)(cmd, args)
+			}
+			return cobra.RangeArgs(1, 2)(cmd, args)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			switch {
+			case opts.FromGitRemote && len(args) == 1:
+				opts.Task = args[0]
+			case len(args) == 2:
+				opts.Task, opts.URL = args[0], args[1]
+			case len(args) == 1:
+				opts.URL = args[0]
+			}
+
+			if opts.PullRequest != 0 && !opts.FromGitRemote {
+				return errors.New("--pr requires --from-git-remote")
+			}
+			if opts.PullRequest < 0 {
+				return fmt.Errorf("invalid pull request number: %d", opts.PullRequest)
+			}
+			if opts.URL != "" {
+				if err := validateURL(opts.URL); err != nil {
+					return err
+				}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runLink(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Name, "name", "n", "", "Name of the link (defaults to the URL)")
+	cmd.Flags().BoolVar(&opts.FromGitRemote, "from-git-remote", false, "Link the current branch on the repository's hosting service")
+	cmd.Flags().IntVar(&opts.PullRequest, "pr", 0, "Link this pull request instead of the branch (with --from-git-remote)")
+
+	return cmd
+}
+
+func runLink(opts *LinkOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	if opts.FromGitRemote {
+		if err := linkFromGitRemote(opts); err != nil {
+			return err
+		}
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	task, err := cmdutils.ResolveTask(&opts.BaseOptions, client, opts.Task, "Select a task to attach the link to:")
+	if err != nil {
+		return err
+	}
+
+	attachments, err := cmdutils.AllAttachments(client, task)
+	if err != nil {
+		return fmt.Errorf("failed to fetch attachments: %w", err)
+	}
+	for _, a := range attachments {
+		if sameURL(a.ViewURL, opts.URL) {
+			opts.IO.Printf("%s %s is already attached to %s as %q\n", cs.WarningIcon, opts.URL, cs.Bold(task.Name), a.Name)
+			return nil
+		}
+	}
+
+	name := opts.Name
+	if name == "" {
+		name = opts.URL
+	}
+
+	_, err = task.CreateExternalAttachment(client, &asana.ExternalAttachmentRequest{
+		Name: name,
+		URL:  opts.URL,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to attach link: %w", err)
+	}
+
+	opts.IO.Printf("%s Attached %s to %s\n", cs.SuccessIcon, opts.URL, cs.Bold(task.Name))
+	return nil
+}
+
+// linkFromGitRemote sets the URL and default name of the link to the current
+// branch or the given pull request on the repository's hosting service.
+func linkFromGitRemote(opts *LinkOptions) error {
+	gc := opts.GitClient
+	if !gc.IsRepository() {
+		return errors.New("not a git repository")
+	}
+
+	branch, err := gc.CurrentBranch()
+	if err != nil {
+		return err
+	}
+
+	remoteName := gc.PushRemote(branch)
+	remoteURL, err := gc.RemoteURL(remoteName)
+	if err != nil {
+		return fmt.Errorf("failed to read remote %q: %w", remoteName, err)
+	}
+
+	remote, err := git.ParseRemoteURL(remoteURL)
+	if err != nil {
+		return err
+	}
+
+	repo := remote.Path[strings.LastIndex(remote.Path, "/")+1:]
+	if opts.PullRequest > 0 {
+		opts.URL = remote.PullRequestURL(opts.PullRequest)
+		if opts.Name == "" {
+			opts.Name = fmt.Sprintf("%s#%d", repo, opts.PullRequest)
+		}
+	} else {
+		opts.URL = remote.BranchURL(branch)
+		if opts.Name == "" {
+			opts.Name = fmt.Sprintf("%s@%s", repo, branch)
+		}
+	}
+
+	return nil
+}
+
+func validateURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid URL %q: must be an absolute http or https URL", raw)
+	}
+	return nil
+}
+
+// sameURL reports whether two URLs point to the same resource, ignoring the
+// case of the scheme and host and a trailing slash.
+func sameURL(a, b string) bool {
+	ua, errA := url.Parse(a)
+	ub, errB := url.Parse(b)
+	if errA != nil || errB != nil || a == "" || b == "" {
+		return false
+	}
+
+	return strings.EqualFold(ua.Scheme, ub.Scheme) &&
+		strings.EqualFold(ua.Host, ub.Host) &&
+		strings.TrimSuffix(ua.Path, "/") == strings.TrimSuffix(ub.Path, "/") &&
+		ua.RawQuery == ub.RawQuery &&
+		ua.Fragment == ub.Fragment
+}
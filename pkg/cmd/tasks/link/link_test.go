@@ -0,0 +1,60 @@
+package link
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+func TestNewCmdLink_Args(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		wantTask string
+		wantURL  string
+		wantErr  string
+	}{
+		{name: "task and url", args: []string{"123", "https://example.com/doc"}, wantTask: "123", wantURL: "https://example.com/doc"},
+		{name: "url only", args: []string{"https://example.com/doc"}, wantURL: "https://example.com/doc"},
+		{name: "from git remote with task", args: []string{"--from-git-remote", "123"}, wantTask: "123"},
+		{name: "from git remote without task", args: []string{"--from-git-remote"}},
+		{name: "missing url", args: []string{}, wantErr: "accepts between 1 and 2 arg(s)"},
+		{name: "invalid url", args: []string{"123", "example.com"}, wantErr: `invalid URL "example.com"`},
+		{name: "unsupported scheme", args: []string{"123", "ftp://example.com/file"}, wantErr: "must be an absolute http or https URL"},
+		{name: "pr without remote", args: []string{"--pr", "4", "https://example.com"}, wantErr: "--pr requires --from-git-remote"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, _, _ := factory.NewTestFactory()
+
+			var sawOpts *LinkOptions
+			cmd := NewCmdLink(f, func(opts *LinkOptions) error {
+				sawOpts = opts
+				return nil
+			})
+			cmd.SetArgs(tt.args)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTask, sawOpts.Task)
+			assert.Equal(t, tt.wantURL, sawOpts.URL)
+		})
+	}
+}
+
+func TestSameURL(t *testing.T) {
+	assert.True(t, sameURL("https://github.com/o/r/pull/1", "HTTPS://GitHub.com/o/r/pull/1/"))
+	assert.False(t, sameURL("https://github.com/o/r/pull/1", "https://github.com/o/r/pull/2"))
+	assert.False(t, sameURL("https://x.io/a?b=1", "https://x.io/a?b=2"))
+	assert.False(t, sameURL("", "https://x.io"))
+}
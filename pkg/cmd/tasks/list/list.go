@@ -4,10 +4,12 @@ import (
 	"fmt"
 
 	"github.com/timwehrle/asana/internal/config"
+	"github.com/timwehrle/asana/internal/prompter"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/spf13/cobra"
 	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmdutils"
 	"github.com/timwehrle/asana/pkg/factory"
 	"github.com/timwehrle/asana/pkg/format"
 	"github.com/timwehrle/asana/pkg/iostreams"
@@ -33,14 +35,16 @@ var validSortOptions = map[SortOption]struct{}{
 }
 
 type ListOptions struct {
-	IO *iostreams.IOStreams
+	IO       *iostreams.IOStreams
+	Prompter prompter.Prompter
 
 	Config func() (*config.Config, error)
 	Client func() (*asana.Client, error)
 
-	Sort  SortOption
-	Limit int
-	User  string
+	Sort    SortOption
+	Limit   int
+	User    string
+	Project string
 }
 
 func (o *ListOptions) ResolveUser() string {
@@ -52,9 +56,10 @@ func (o *ListOptions) ResolveUser() string {
 
 func NewCmdList(f factory.Factory, runF func(*ListOptions) error) *cobra.Command {
 	opts := &ListOptions{
-		IO:     f.IOStreams,
-		Config: f.Config,
-		Client: f.Client,
+		IO:       f.IOStreams,
+		Prompter: f.Prompter,
+		Config:   f.Config,
+		Client:   f.Client,
 	}
 
 	cmd := &cobra.Command{
@@ -71,6 +76,12 @@ func NewCmdList(f factory.Factory, runF func(*ListOptions) error) *cobra.Command
 
 				# List tasks sorted by due date (descending)
 				$ asana task list --sort due-desc
+
+				# List the tasks of the project linked to the current directory
+				$ asana tasks list --project
+
+				# List the tasks of a project by name
+				$ asana tasks list --project "Website"
 			`),
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -78,6 +89,10 @@ func NewCmdList(f factory.Factory, runF func(*ListOptions) error) *cobra.Command
 				return err
 			}
 
+			if opts.Project != "" && opts.User != "" {
+				return fmt.Errorf("--project cannot be used with --user")
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -90,6 +105,8 @@ func NewCmdList(f factory.Factory, runF func(*ListOptions) error) *cobra.Command
 		StringVarP((*string)(&opts.Sort), "sort", "s", "", "Sort tasks by name, due date, creation date (options: asc, desc, due, due-desc, created-at)")
 	cmd.Flags().IntVarP(&opts.Limit, "limit", "l", 0, "Limit the tasks to display")
 	cmd.Flags().StringVarP(&opts.User, "user", "u", "", "Show the task list of the provided user")
+	cmd.Flags().StringVarP(&opts.Project, "project", "p", "", "Show the tasks of a project (the linked project if no name or ID is given)")
+	cmd.Flags().Lookup("project").NoOptDefVal = cmdutils.LinkedProject
 
 	return cmd
 }
@@ -114,7 +131,33 @@ func listRun(opts *ListOptions) error {
 		return fmt.Errorf("failed to get config: %w", err)
 	}
 
-	tasks, err := fetchTasks(opts, cfg.Workspace.ID, opts.Limit)
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to create Asana client: %w", err)
+	}
+
+	query := &asana.TaskQuery{
+		Assignee:       opts.ResolveUser(),
+		Workspace:      cfg.Workspace.ID,
+		CompletedSince: "now",
+	}
+	title := fmt.Sprintf("Tasks for %s", opts.IO.ColorScheme().Bold(cfg.Username))
+
+	if opts.Project != "" {
+		project, err := cmdutils.ResolveProject(client, cfg, opts.Prompter, opts.Project)
+		if err != nil {
+			return err
+		}
+
+		// The API rejects assignee and workspace filters together with a project
+		query = &asana.TaskQuery{
+			Project:        project.ID,
+			CompletedSince: "now",
+		}
+		title = fmt.Sprintf("Tasks in %s", opts.IO.ColorScheme().Bold(project.Name))
+	}
+
+	tasks, err := fetchTasks(client, query, opts.Limit)
 	if err != nil {
 		return err
 	}
@@ -125,26 +168,15 @@ func listRun(opts *ListOptions) error {
 
 	sortTasks(tasks, opts.Sort)
 
-	return printTasks(opts.IO, cfg.Username, tasks)
+	return printTasks(opts.IO, title, tasks)
 }
 
-func fetchTasks(opts *ListOptions, workspaceID string, limit int) ([]*asana.Task, error) {
+func fetchTasks(client *asana.Client, query *asana.TaskQuery, limit int) ([]*asana.Task, error) {
 	initialCapacity := 100
 	if limit > 0 {
 		initialCapacity = limit
 	}
 
-	client, err := opts.Client()
-	if err != nil {
-		return nil, fmt.Errorf("failed to create Asana client: %w", err)
-	}
-
-	query := &asana.TaskQuery{
-		Assignee:       opts.ResolveUser(),
-		Workspace:      workspaceID,
-		CompletedSince: "now",
-	}
-
 	tasks := make([]*asana.Task, 0, initialCapacity)
 	options := &asana.Options{
 		Fields: []string{"name", "due_on", "created_at"},
@@ -196,10 +228,10 @@ func printEmptyMessage(io *iostreams.IOStreams) error {
 	return nil
 }
 
-func printTasks(io *iostreams.IOStreams, username string, tasks []*asana.Task) error {
+func printTasks(io *iostreams.IOStreams, title string, tasks []*asana.Task) error {
 	cs := io.ColorScheme()
 
-	fmt.Fprintf(io.Out, "\nTasks for %s:\n\n", cs.Bold(username))
+	fmt.Fprintf(io.Out, "\n%s:\n\n", title)
 
 	for i, task := range tasks {
 		fmt.Fprintf(io.Out, "%d. [%s] %s\n",
@@ -0,0 +1,192 @@
+package move
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+	"github.com/timwehrle/asana/pkg/format"
+)
+
+type MoveOptions struct {
+	cmdutils.BaseOptions
+
+	Task              string
+	Project           string
+	Section           string
+	Before            string
+	After             string
+	KeepOtherProjects bool
+}
+
+func NewCmdMove(f factory.Factory, runF func(*MoveOptions) error) *cobra.Command {
+	opts := &MoveOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:        f.IOStreams,
+			Prompter:  f.Prompter,
+			Config:    f.Config,
+			Client:    f.Client,
+			GitClient: f.GitClient,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "move [<task>]",
+		Short: "Move a task to another project or section",
+		Long: heredoc.Doc(`
+				Move a task to a project and section, or to a position next to another
+				task with --before or --after. The task is removed from its other
+				projects unless --keep-other-projects is set.
+
+				Projects and sections can be given by name or ID, and tasks by ID or URL,
+				or by name for --before and --after. Without --project you pick the
+				project and then the section interactively.
+			`),
+		Example: heredoc.Doc(`
+				$ asana tasks move 1204567890123 --project "Website" --section "In Progress"
+				$ asana tasks move 1204567890123 --project "Website" --after "Fix login bug"
+				$ asana tasks move --keep-other-projects
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Task = args[0]
+			}
+			if opts.Before != "" && opts.After != "" {
+				return errors.New("specify only one of --before or --after")
+			}
+			if opts.Section != "" && opts.Before+opts.After != "" {
+				return errors.New("--section cannot be combined with --before or --after; the task is placed in the section of that task")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runMove(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Project, "project", "p", "", "Project to move the task to")
+	cmd.Flags().StringVarP(&opts.Section, "section", "s", "", "Section to move the task to")
+	cmd.Flags().StringVar(&opts.Before, "before", "", "Place the task before this task")
+	cmd.Flags().StringVar(&opts.After, "after", "", "Place the task after this task")
+	cmd.Flags().BoolVar(&opts.KeepOtherProjects, "keep-other-projects", false, "Keep the task in its other projects")
+
+	return cmd
+}
+
+func runMove(opts *MoveOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	task, err := cmdutils.ResolveTask(&opts.BaseOptions, client, opts.Task, "Select a task to move:")
+	if err != nil {
+		return err
+	}
+
+	project, err := cmdutils.ResolveProject(client, cfg, opts.Prompter, opts.Project)
+	if err != nil {
+		return err
+	}
+
+	request := &asana.AddProjectRequest{Project: project.ID}
+	destination := project.Name
+
+	switch {
+	case opts.Before != "" || opts.After != "":
+		anchor, err := findTask(client, project, opts.Before+opts.After)
+		if err != nil {
+			return err
+		}
+		if anchor.ID == task.ID {
+			return errors.New("cannot place a task relative to itself")
+		}
+		if opts.Before != "" {
+			request.InsertBefore = anchor.ID
+			destination += " before " + anchor.Name
+		} else {
+			request.InsertAfter = anchor.ID
+			destination += " after " + anchor.Name
+		}
+	case opts.Section != "" || opts.Project == "":
+		// Walk from the project to its sections when moving interactively.
+		section, err := cmdutils.ResolveSection(client, cfg, opts.Prompter, project, opts.Section)
+		if err != nil {
+			return err
+		}
+		request.Section = section.ID
+		destination += " › " + section.Name
+	}
+
+	if err := task.AddProject(client, request); err != nil {
+		return fmt.Errorf("failed to move task: %w", err)
+	}
+
+	var removed []string
+	if !opts.KeepOtherProjects {
+		for _, p := range task.Projects {
+			if p.ID == project.ID {
+				continue
+			}
+			if err := task.RemoveProject(client, p.ID); err != nil {
+				return fmt.Errorf("moved task but failed to remove it from %q: %w", p.Name, err)
+			}
+			removed = append(removed, p.Name)
+		}
+	}
+
+	opts.IO.Printf("%s Moved %s to %s\n", cs.SuccessIcon, cs.Bold(task.Name), destination)
+	if len(removed) > 0 {
+		opts.IO.Println(cs.Gray(format.List("Removed from: ", removed)))
+	}
+
+	return nil
+}
+
+// findTask returns the task of project identified by ref, a task ID, URL or
+// name.
+func findTask(client *asana.Client, project *asana.Project, ref string) (*asana.Task, error) {
+	if id, err := cmdutils.ParseTaskRef(ref); err == nil {
+		task := &asana.Task{ID: id}
+		if err := task.Fetch(client); err != nil {
+			if asana.IsNotFoundError(err) {
+				return nil, fmt.Errorf("task %s not found", id)
+			}
+			return nil, fmt.Errorf("failed to fetch task details: %w", err)
+		}
+		return task, nil
+	}
+
+	options := &asana.Options{Limit: 100, Fields: []string{"name"}}
+	for {
+		batch, nextPage, err := project.Tasks(client, options)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch tasks for project %q: %w", project.Name, err)
+		}
+		for _, t := range batch {
+			if strings.EqualFold(t.Name, ref) {
+				return t, nil
+			}
+		}
+
+		if nextPage == nil || nextPage.Offset == "" {
+			return nil, fmt.Errorf("task %q not found in project %q", ref, project.Name)
+		}
+		options.Offset = nextPage.Offset
+	}
+}
@@ -0,0 +1,68 @@
+package move
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+func TestNewCmdMove(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    MoveOptions
+		wantErr string
+	}{
+		{
+			name: "project and section",
+			args: []string{"123", "--project", "Website", "--section", "Doing"},
+			want: MoveOptions{Task: "123", Project: "Website", Section: "Doing"},
+		},
+		{
+			name: "after task, keeping other projects",
+			args: []string{"--project", "Website", "--after", "Fix login", "--keep-other-projects"},
+			want: MoveOptions{Project: "Website", After: "Fix login", KeepOtherProjects: true},
+		},
+		{
+			name:    "before and after",
+			args:    []string{"123", "--before", "a", "--after", "b"},
+			wantErr: "specify only one of --before or --after",
+		},
+		{
+			name:    "section and position",
+			args:    []string{"123", "--section", "Doing", "--before", "a"},
+			wantErr: "--section cannot be combined with --before or --after",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, _, _ := factory.NewTestFactory()
+
+			var sawOpts *MoveOptions
+			cmd := NewCmdMove(f, func(opts *MoveOptions) error {
+				sawOpts = opts
+				return nil
+			})
+			cmd.SetArgs(tt.args)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.Task, sawOpts.Task)
+			assert.Equal(t, tt.want.Project, sawOpts.Project)
+			assert.Equal(t, tt.want.Section, sawOpts.Section)
+			assert.Equal(t, tt.want.Before, sawOpts.Before)
+			assert.Equal(t, tt.want.After, sawOpts.After)
+			assert.Equal(t, tt.want.KeepOtherProjects, sawOpts.KeepOtherProjects)
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package reparent
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+// noParent is the --parent value that turns a subtask into a standalone task.
+const noParent = "none"
+
+type ReparentOptions struct {
+	cmdutils.BaseOptions
+
+	Task         string
+	Parent       string
+	InsertAfter  string
+	InsertBefore string
+}
+
+func NewCmdReparent(f factory.Factory, runF func(*ReparentOptions) error) *cobra.Command {
+	opts := &ReparentOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:        f.IOStreams,
+			Prompter:  f.Prompter,
+			Config:    f.Config,
+			Client:    f.Client,
+			GitClient: f.GitClient,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "reparent [<task>] --parent <task>",
+		Short: "Move a task below another parent task",
+		Long: heredoc.Doc(`
+				Make a task a subtask of another task, or a standalone task again with
+				--parent none.
+
+				New subtasks are added at the end of the parent's subtasks. Place them
+				with --insert-after or --insert-before, which take a subtask of the new
+				parent, or "-" for the beginning (after) or end (before) of the list.
+			`),
+		Example: heredoc.Doc(`
+				$ asana tasks reparent 1204567890123 --parent 1204567890999
+				$ asana tasks reparent 1204567890123 --parent 1204567890999 --insert-after -
+				$ asana tasks reparent 1204567890123 --parent none
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Task = args[0]
+			}
+			if opts.InsertAfter != "" && opts.InsertBefore != "" {
+				return errors.New("specify only one of --insert-after or --insert-before")
+			}
+			if strings.EqualFold(opts.Parent, noParent) && opts.InsertAfter+opts.InsertBefore != "" {
+				return errors.New("--insert-after and --insert-before require a parent")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runReparent(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Parent, "parent", "", `New parent task ID or URL, or "none"`)
+	cmd.Flags().StringVar(&opts.InsertAfter, "insert-after", "", "Place the task after this subtask of the parent")
+	cmd.Flags().StringVar(&opts.InsertBefore, "insert-before", "", "Place the task before this subtask of the parent")
+	_ = cmd.MarkFlagRequired("parent")
+
+	return cmd
+}
+
+func runReparent(opts *ReparentOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	task, err := cmdutils.ResolveTask(&opts.BaseOptions, client, opts.Task, "Select a task to move:")
+	if err != nil {
+		return err
+	}
+
+	request := &asana.SetParentRequest{}
+	var parent *asana.Task
+
+	if !strings.EqualFold(opts.Parent, noParent) {
+		parent, err = cmdutils.ResolveTask(&opts.BaseOptions, client, opts.Parent, "")
+		if err != nil {
+			return err
+		}
+		if parent.ID == task.ID {
+			return errors.New("a task cannot be its own parent")
+		}
+		request.Parent = parent.ID
+
+		if request.InsertAfter, err = subtaskRef(opts.InsertAfter); err != nil {
+			return err
+		}
+		if request.InsertBefore, err = subtaskRef(opts.InsertBefore); err != nil {
+			return err
+		}
+	}
+
+	if err := task.SetParent(client, request); err != nil {
+		return fmt.Errorf("failed to change parent: %w", err)
+	}
+
+	if parent == nil {
+		opts.IO.Printf("%s %s is no longer a subtask\n", cs.SuccessIcon, cs.Bold(task.Name))
+	} else {
+		opts.IO.Printf("%s Moved %s below %s\n", cs.SuccessIcon, cs.Bold(task.Name), cs.Bold(parent.Name))
+	}
+	return nil
+}
+
+// subtaskRef returns the GID of a subtask reference, keeping "-" and empty
+// values as they are.
+func subtaskRef(ref string) (string, error) {
+	if ref == "" || ref == "-" {
+		return ref, nil
+	}
+	return cmdutils.ParseTaskRef(ref)
+}
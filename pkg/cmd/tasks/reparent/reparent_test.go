@@ -0,0 +1,60 @@
+package reparent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+func TestNewCmdReparent(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "parent", args: []string{"123", "--parent", "456"}},
+		{name: "parent and position", args: []string{"123", "--parent", "456", "--insert-after", "-"}},
+		{name: "detach", args: []string{"123", "--parent", "none"}},
+		{name: "missing parent", args: []string{"123"}, wantErr: `required flag(s) "parent" not set`},
+		{name: "both positions", args: []string{"123", "--parent", "456", "--insert-after", "1", "--insert-before", "2"}, wantErr: "specify only one of --insert-after or --insert-before"},
+		{name: "position without parent", args: []string{"123", "--parent", "none", "--insert-after", "1"}, wantErr: "--insert-after and --insert-before require a parent"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, _, _ := factory.NewTestFactory()
+
+			called := false
+			cmd := NewCmdReparent(f, func(opts *ReparentOptions) error {
+				called = true
+				assert.Equal(t, "123", opts.Task)
+				return nil
+			})
+			cmd.SetArgs(tt.args)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, called)
+		})
+	}
+}
+
+func TestSubtaskRef(t *testing.T) {
+	for _, ref := range []string{"", "-", "1204567890123"} {
+		got, err := subtaskRef(ref)
+		require.NoError(t, err)
+		assert.Equal(t, ref, got)
+	}
+
+	got, err := subtaskRef("https://app.asana.com/0/0/1204567890123")
+	require.NoError(t, err)
+	assert.Equal(t, "1204567890123", got)
+}
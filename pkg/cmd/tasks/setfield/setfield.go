@@ -0,0 +1,122 @@
+package setfield
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+	"github.com/timwehrle/asana/pkg/format"
+)
+
+type SetFieldOptions struct {
+	cmdutils.BaseOptions
+
+	Task   string
+	Fields []string
+}
+
+func NewCmdSetField(f factory.Factory, runF func(*SetFieldOptions) error) *cobra.Command {
+	opts := &SetFieldOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:        f.IOStreams,
+			Prompter:  f.Prompter,
+			Config:    f.Config,
+			Client:    f.Client,
+			GitClient: f.GitClient,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "set-field [<task>] <name>=<value>...",
+		Short: "Set custom field values of a task",
+		Long: heredoc.Doc(`
+				Set the values of custom fields on a task. Fields are looked up by name in
+				the custom fields of the task's projects.
+
+				Values are parsed according to the field type:
+				- number: a number with at most as many decimal places as the field allows
+				- percentage: a number in percent, with or without a % sign, so 50 and 50% both mean 50%
+				- boolean: true or false
+				- date: YYYY-MM-DD, today or tomorrow
+				- enum: the name of an option
+				- multi_enum: option names separated by commas
+				- people: names, emails or "me" separated by commas
+
+				An empty value, or None for dates, clears the field.
+			`),
+		Example: heredoc.Doc(`
+				$ asana tasks set-field 1204567890123 "Priority=High" "Estimate=3.5"
+				$ asana tasks set-field "Reviewers=alice@example.com,me"
+				$ asana tasks set-field 1204567890123 "Priority="
+			`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Task URLs may contain "=" in their query, so the task is
+			// recognized by its reference rather than by the missing "=".
+			if _, err := cmdutils.ParseTaskRef(args[0]); err == nil {
+				opts.Task, args = args[0], args[1:]
+			}
+			if len(args) == 0 {
+				return errors.New("specify at least one field as <name>=<value>")
+			}
+			opts.Fields = args
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runSetField(opts)
+		},
+	}
+
+	return cmd
+}
+
+func runSetField(opts *SetFieldOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	task, err := cmdutils.ResolveTask(&opts.BaseOptions, client, opts.Task, "Select a task to set fields on:")
+	if err != nil {
+		return err
+	}
+
+	fields, err := cmdutils.ProjectCustomFields(client, task.Projects)
+	if err != nil {
+		return err
+	}
+
+	values, err := cmdutils.CustomFieldValues(fields, opts.Fields, cmdutils.UserIDResolver(client, cfg))
+	if err != nil {
+		return err
+	}
+
+	if err := task.Update(client, &asana.UpdateTaskRequest{CustomFields: values}); err != nil {
+		if asana.IsForbiddenError(err) {
+			return fmt.Errorf("failed to set custom fields: %w", cmdutils.ErrLockedField)
+		}
+		return fmt.Errorf("failed to set custom fields: %w", err)
+	}
+
+	opts.IO.Printf("%s Updated %s\n", cs.SuccessIcon, cs.Bold(task.Name))
+	for _, f := range task.CustomFields {
+		if _, ok := values[f.ID]; ok {
+			opts.IO.Printf("  %s %s\n", cs.Gray(f.Name+":"), format.CustomFieldValue(f))
+		}
+	}
+
+	return nil
+}
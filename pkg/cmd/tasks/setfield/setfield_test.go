@@ -0,0 +1,49 @@
+package setfield
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+func TestNewCmdSetField(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		wantTask   string
+		wantFields []string
+		wantErr    string
+	}{
+		{name: "task and fields", args: []string{"123", "Priority=High", "Estimate=3.5"}, wantTask: "123", wantFields: []string{"Priority=High", "Estimate=3.5"}},
+		{name: "task URL with query", args: []string{"https://app.asana.com/0/1/2?focus=true", "Priority=High"}, wantTask: "https://app.asana.com/0/1/2?focus=true", wantFields: []string{"Priority=High"}},
+		{name: "fields only", args: []string{"Priority=High"}, wantFields: []string{"Priority=High"}},
+		{name: "task only", args: []string{"123"}, wantErr: "specify at least one field as <name>=<value>"},
+		{name: "no args", args: []string{}, wantErr: "requires at least 1 arg(s), only received 0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, _, _ := factory.NewTestFactory()
+
+			var got *SetFieldOptions
+			cmd := NewCmdSetField(f, func(opts *SetFieldOptions) error {
+				got = opts
+				return nil
+			})
+			cmd.SetArgs(tt.args)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTask, got.Task)
+			assert.Equal(t, tt.wantFields, got.Fields)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package add
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/convert"
+	"github.com/timwehrle/asana/pkg/factory"
+	"github.com/timwehrle/asana/pkg/format"
+)
+
+type AddOptions struct {
+	cmdutils.BaseOptions
+
+	Parent      string
+	Name        string
+	Assignee    string
+	Due         string
+	Description string
+}
+
+func NewCmdAdd(f factory.Factory, runF func(*AddOptions) error) *cobra.Command {
+	opts := &AddOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:        f.IOStreams,
+			Prompter:  f.Prompter,
+			Config:    f.Config,
+			Client:    f.Client,
+			GitClient: f.GitClient,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "add [<parent>]",
+		Short: "Add a subtask to a task",
+		Long: heredoc.Doc(`
+				Create a subtask below a parent task. Without a parent, the task encoded
+				in the current git branch name is used, or you are asked to select one.
+			`),
+		Example: heredoc.Doc(`
+				$ asana tasks subtask add 1204567890123 --name "Write tests" --assignee me --due tomorrow
+				$ asana tasks subtask add --name "Update docs"
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Parent = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runAdd(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Name, "name", "n", "", "Subtask name")
+	cmd.Flags().StringVarP(&opts.Assignee, "assignee", "a", "", "Assignee name, email, ID or 'me'")
+	cmd.Flags().StringVarP(&opts.Due, "due", "d", "", "Due date (YYYY-MM-DD, 'today', 'tomorrow')")
+	cmd.Flags().StringVarP(&opts.Description, "description", "m", "", "Subtask description")
+
+	return cmd
+}
+
+func runAdd(opts *AddOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	due, err := convert.ToDueDate(opts.Due, time.Now())
+	if err != nil {
+		return err
+	}
+
+	parent, err := cmdutils.ResolveTask(&opts.BaseOptions, client, opts.Parent, "Select a task to add a subtask to:")
+	if err != nil {
+		return err
+	}
+
+	name := strings.TrimSpace(opts.Name)
+	if name == "" {
+		name, err = opts.Prompter.Input("Subtask name:", "")
+		if err != nil {
+			return fmt.Errorf("failed to read subtask name: %w", err)
+		}
+		name = strings.TrimSpace(name)
+	}
+	if name == "" {
+		return errors.New("subtask name cannot be empty")
+	}
+
+	req := &asana.CreateTaskRequest{
+		TaskBase: asana.TaskBase{
+			Name:  name,
+			Notes: opts.Description,
+			DueOn: due,
+		},
+	}
+
+	var assignee *asana.User
+	if opts.Assignee != "" {
+		assignee, err = cmdutils.ResolveUser(client, cfg, opts.Assignee)
+		if err != nil {
+			return err
+		}
+		req.Assignee = assignee.ID
+	}
+
+	if err := req.Validate(); err != nil {
+		return fmt.Errorf("task validation failed: %w", err)
+	}
+
+	subtask, err := parent.CreateSubtask(client, req)
+	if err != nil {
+		return fmt.Errorf("failed to create subtask: %w", err)
+	}
+
+	opts.IO.Printf("%s Added subtask %s to %s\n", cs.SuccessIcon, cs.Bold(subtask.Name), parent.Name)
+	if assignee != nil {
+		opts.IO.Printf("  %s %s\n", cs.Gray("Assignee:"), assignee.Name)
+	}
+	if subtask.DueOn != nil {
+		opts.IO.Printf("  %s %s\n", cs.Gray("Due:"), format.Date(subtask.DueOn))
+	}
+	if subtask.PermalinkURL != "" {
+		opts.IO.Printf("  %s %s\n", cs.Gray("URL:"), subtask.PermalinkURL)
+	}
+
+	return nil
+}
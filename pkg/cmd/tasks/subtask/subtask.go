@@ -0,0 +1,19 @@
+package subtask
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/pkg/cmd/tasks/subtask/add"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+func NewCmdSubtask(f factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subtask <subcommand>",
+		Short: "Manage subtasks",
+		Long:  "Perform operations on the subtasks of a task.",
+	}
+
+	cmd.AddCommand(add.NewCmdAdd(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,103 @@
+package subtasks
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type SubtasksOptions struct {
+	cmdutils.BaseOptions
+
+	Task  string
+	Depth int
+	JSON  bool
+}
+
+func NewCmdSubtasks(f factory.Factory, runF func(*SubtasksOptions) error) *cobra.Command {
+	opts := &SubtasksOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:        f.IOStreams,
+			Prompter:  f.Prompter,
+			Config:    f.Config,
+			Client:    f.Client,
+			GitClient: f.GitClient,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "subtasks [<task>]",
+		Short: "Show the subtasks of a task as a tree",
+		Long: heredoc.Doc(`
+				Show the subtasks of a task, and their subtasks, as a tree with completion
+				checkboxes, due dates and assignees. Limit the levels shown with --depth.
+			`),
+		Example: heredoc.Doc(`
+				$ asana tasks subtasks 1204567890123
+				$ asana tasks subtasks --depth 1
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Task = args[0]
+			}
+			if opts.Depth < 0 {
+				return fmt.Errorf("invalid depth: %d", opts.Depth)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runSubtasks(opts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&opts.Depth, "depth", "d", 0, "Maximum number of levels to show (0 for all)")
+	cmd.Flags().BoolVar(&opts.JSON, "json", false, "Output as JSON")
+
+	return cmd
+}
+
+func runSubtasks(opts *SubtasksOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	task, err := cmdutils.ResolveTask(&opts.BaseOptions, client, opts.Task, "Select a task to show the subtasks of:")
+	if err != nil {
+		return err
+	}
+
+	tree, err := cmdutils.SubtaskTree(client, task, opts.Depth)
+	if err != nil {
+		return err
+	}
+
+	if opts.JSON {
+		if tree == nil {
+			tree = []*cmdutils.TaskNode{}
+		}
+		enc := json.NewEncoder(opts.IO.Out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(tree)
+	}
+
+	if len(tree) == 0 {
+		opts.IO.Printf("%s has no subtasks\n", cs.Bold(task.Name))
+		return nil
+	}
+
+	total, completed := cmdutils.CountTasks(tree)
+	opts.IO.Printf("%s %s\n", cs.Bold(task.Name), cs.Gray(fmt.Sprintf("(%d/%d completed)", completed, total)))
+	cmdutils.PrintTaskTree(opts.IO, tree, "")
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package tag
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/tags/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+	"github.com/timwehrle/asana/pkg/format"
+)
+
+type TagOptions struct {
+	cmdutils.BaseOptions
+
+	Task   string
+	Tags   []string
+	Create bool
+}
+
+func NewCmdTag(f factory.Factory, runF func(*TagOptions) error) *cobra.Command {
+	opts := &TagOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:        f.IOStreams,
+			Prompter:  f.Prompter,
+			Config:    f.Config,
+			Client:    f.Client,
+			GitClient: f.GitClient,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "tag [<task>] <tag>...",
+		Short: "Add tags to a task",
+		Long: heredoc.Doc(`
+				Add one or more tags to a task. Tags are matched by name, regardless of
+				case, or by ID.
+
+				When the first of several arguments is a task ID or URL, it names the task.
+				Otherwise the task of the current git branch is used, or you are prompted
+				to select one.
+
+				Tags that do not exist yet are created after confirmation, or right away
+				with --create.
+			`),
+		Example: heredoc.Doc(`
+				$ asana tasks tag 1204567890123 urgent backend
+				$ asana tasks tag 1204567890123 "needs review" --create
+				$ asana tasks tag urgent
+			`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if _, err := cmdutils.ParseTaskRef(args[0]); err == nil && len(args) > 1 {
+				opts.Task, args = args[0], args[1:]
+			}
+			opts.Tags = args
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runTag(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Create, "create", false, "Create missing tags without asking")
+
+	return cmd
+}
+
+func runTag(opts *TagOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	task, err := cmdutils.ResolveTask(&opts.BaseOptions, client, opts.Task, "Select a task to tag:")
+	if err != nil {
+		return err
+	}
+
+	ws := &asana.Workspace{ID: cfg.Workspace.ID, Name: cfg.Workspace.Name}
+	all, err := ws.AllTags(client)
+	if err != nil {
+		return fmt.Errorf("failed to fetch tags: %w", err)
+	}
+
+	tags, missing := shared.FindTags(all, opts.Tags)
+	if len(missing) > 0 {
+		if err := confirmCreate(opts, missing); err != nil {
+			return err
+		}
+		for _, name := range missing {
+			tag, err := ws.CreateTag(client, &asana.TagBase{Name: name})
+			if err != nil {
+				return fmt.Errorf("failed to create tag %q: %w", name, err)
+			}
+			opts.IO.Printf("%s Created tag %s\n", cs.SuccessIcon, cs.Bold(tag.Name))
+			tags = append(tags, tag)
+		}
+	}
+
+	for _, tag := range tags {
+		if err := task.AddTag(client, tag.ID); err != nil {
+			return fmt.Errorf("failed to add tag %s: %w", tag.Name, err)
+		}
+	}
+
+	opts.IO.Printf("%s Tagged %s with %s\n", cs.SuccessIcon, cs.Bold(task.Name), format.List("", names(tags)))
+	return nil
+}
+
+// confirmCreate returns nil if the missing tags may be created, either
+// because of --create or because the user agreed.
+func confirmCreate(opts *TagOptions, missing []string) error {
+	if opts.Create {
+		return nil
+	}
+
+	quoted := make([]string, len(missing))
+	for i, name := range missing {
+		quoted[i] = fmt.Sprintf("%q", name)
+	}
+	list := strings.Join(quoted, ", ")
+
+	if !opts.IO.IsStdinTTY {
+		return fmt.Errorf("tag(s) %s not found in workspace; use --create to create them", list)
+	}
+
+	confirmed, err := opts.Prompter.Confirm(fmt.Sprintf("Create tag(s) %s?", list), "No")
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return fmt.Errorf("tag(s) %s not found in workspace", list)
+	}
+	return nil
+}
+
+func names(tags []*asana.Tag) []string {
+	return format.MapToStrings(tags, func(t *asana.Tag) string {
+		return t.Name
+	})
+}
@@ -0,0 +1,128 @@
+package tag
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/internal/prompter"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+	"github.com/timwehrle/asana/pkg/iostreams"
+)
+
+func TestNewCmdTag(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		wantTask   string
+		wantTags   []string
+		wantCreate bool
+		wantErr    string
+	}{
+		{
+			name:       "task and tags",
+			args:       []string{"123", "urgent", "needs review", "--create"},
+			wantTask:   "123",
+			wantTags:   []string{"urgent", "needs review"},
+			wantCreate: true,
+		},
+		{
+			name:     "task URL",
+			args:     []string{"https://app.asana.com/0/1/123", "urgent"},
+			wantTask: "https://app.asana.com/0/1/123",
+			wantTags: []string{"urgent"},
+		},
+		{
+			name:     "tags only",
+			args:     []string{"urgent", "backend"},
+			wantTags: []string{"urgent", "backend"},
+		},
+		{
+			name:     "single ID is a tag",
+			args:     []string{"123"},
+			wantTags: []string{"123"},
+		},
+		{
+			name:    "no tags",
+			args:    []string{},
+			wantErr: "requires at least 1 arg(s), only received 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, _, _ := factory.NewTestFactory()
+
+			var got *TagOptions
+			cmd := NewCmdTag(f, func(opts *TagOptions) error {
+				got = opts
+				return nil
+			})
+			cmd.SetArgs(tt.args)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTask, got.Task)
+			assert.Equal(t, tt.wantTags, got.Tags)
+			assert.Equal(t, tt.wantCreate, got.Create)
+		})
+	}
+}
+
+func TestConfirmCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		create  bool
+		tty     bool
+		answer  bool
+		wantErr string
+	}{
+		{
+			name:   "create flag",
+			create: true,
+		},
+		{
+			name:    "not interactive",
+			wantErr: `tag(s) "urgent" not found in workspace; use --create to create them`,
+		},
+		{
+			name:   "confirmed",
+			tty:    true,
+			answer: true,
+		},
+		{
+			name:    "declined",
+			tty:     true,
+			wantErr: `tag(s) "urgent" not found in workspace`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			ios.IsStdinTTY = tt.tty
+
+			p := prompter.NewMockPrompter()
+			p.On("Confirm", `Create tag(s) "urgent"?`, "No").Return(tt.answer, nil)
+
+			opts := &TagOptions{
+				BaseOptions: cmdutils.BaseOptions{IO: ios, Prompter: p},
+				Create:      tt.create,
+			}
+
+			err := confirmCreate(opts, []string{"urgent"})
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
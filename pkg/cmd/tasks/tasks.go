@@ -2,9 +2,25 @@ package tasks
 
 import (
 	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/pkg/cmd/tasks/attachments"
+	"github.com/timwehrle/asana/pkg/cmd/tasks/blockers"
+	"github.com/timwehrle/asana/pkg/cmd/tasks/comment"
+	"github.com/timwehrle/asana/pkg/cmd/tasks/comments"
 	"github.com/timwehrle/asana/pkg/cmd/tasks/create"
+	"github.com/timwehrle/asana/pkg/cmd/tasks/depend"
+	"github.com/timwehrle/asana/pkg/cmd/tasks/download"
+	"github.com/timwehrle/asana/pkg/cmd/tasks/history"
+	"github.com/timwehrle/asana/pkg/cmd/tasks/link"
 	"github.com/timwehrle/asana/pkg/cmd/tasks/list"
+	"github.com/timwehrle/asana/pkg/cmd/tasks/move"
+	"github.com/timwehrle/asana/pkg/cmd/tasks/reparent"
 	"github.com/timwehrle/asana/pkg/cmd/tasks/search"
+	"github.com/timwehrle/asana/pkg/cmd/tasks/setfield"
+	"github.com/timwehrle/asana/pkg/cmd/tasks/subtask"
+	"github.com/timwehrle/asana/pkg/cmd/tasks/subtasks"
+	"github.com/timwehrle/asana/pkg/cmd/tasks/tag"
+	"github.com/timwehrle/asana/pkg/cmd/tasks/undepend"
+	"github.com/timwehrle/asana/pkg/cmd/tasks/untag"
 	"github.com/timwehrle/asana/pkg/cmd/tasks/update"
 	"github.com/timwehrle/asana/pkg/cmd/tasks/view"
 	"github.com/timwehrle/asana/pkg/factory"
@@ -23,6 +39,22 @@ func NewCmdTasks(f factory.Factory) *cobra.Command {
 	cmd.AddCommand(update.NewCmdUpdate(f, nil))
 	cmd.AddCommand(search.NewCmdSearch(f, nil))
 	cmd.AddCommand(create.NewCmdCreate(f, nil))
+	cmd.AddCommand(comment.NewCmdComment(f, nil))
+	cmd.AddCommand(comments.NewCmdComments(f, nil))
+	cmd.AddCommand(history.NewCmdHistory(f, nil))
+	cmd.AddCommand(attachments.NewCmdAttachments(f, nil))
+	cmd.AddCommand(download.NewCmdDownload(f, nil))
+	cmd.AddCommand(link.NewCmdLink(f, nil))
+	cmd.AddCommand(move.NewCmdMove(f, nil))
+	cmd.AddCommand(subtasks.NewCmdSubtasks(f, nil))
+	cmd.AddCommand(subtask.NewCmdSubtask(f))
+	cmd.AddCommand(reparent.NewCmdReparent(f, nil))
+	cmd.AddCommand(depend.NewCmdDepend(f, nil))
+	cmd.AddCommand(undepend.NewCmdUndepend(f, nil))
+	cmd.AddCommand(blockers.NewCmdBlockers(f, nil))
+	cmd.AddCommand(setfield.NewCmdSetField(f, nil))
+	cmd.AddCommand(tag.NewCmdTag(f, nil))
+	cmd.AddCommand(untag.NewCmdUntag(f, nil))
 
 	return cmd
 }
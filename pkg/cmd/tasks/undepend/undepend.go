@@ -0,0 +1,122 @@
+package undepend
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+	"github.com/timwehrle/asana/pkg/format"
+)
+
+type UndependOptions struct {
+	cmdutils.BaseOptions
+
+	Task string
+	On   []string
+}
+
+func NewCmdUndepend(f factory.Factory, runF func(*UndependOptions) error) *cobra.Command {
+	opts := &UndependOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:        f.IOStreams,
+			Prompter:  f.Prompter,
+			Config:    f.Config,
+			Client:    f.Client,
+			GitClient: f.GitClient,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "undepend [<task>] [--on <task>...]",
+		Short: "Remove dependencies from a task",
+		Long: heredoc.Doc(`
+				Remove tasks from the dependencies of a task, so they no longer block it.
+				Without --on, select one of the task's dependencies.
+			`),
+		Example: heredoc.Doc(`
+				$ asana tasks undepend 1204567890123 --on 1204567890456
+				$ asana tasks undepend
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Task = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runUndepend(opts)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&opts.On, "on", nil, "IDs or URLs of the dependencies to remove")
+
+	return cmd
+}
+
+func runUndepend(opts *UndependOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	task, err := cmdutils.ResolveTask(&opts.BaseOptions, client, opts.Task, "Select the blocked task:")
+	if err != nil {
+		return err
+	}
+
+	var removed []*asana.Task
+	if len(opts.On) > 0 {
+		removed, err = cmdutils.ResolveTasks(client, opts.On)
+		if err != nil {
+			return err
+		}
+	} else {
+		dependency, err := selectDependency(opts, client, task)
+		if err != nil {
+			return err
+		}
+		removed = []*asana.Task{dependency}
+	}
+
+	if err := task.RemoveDependencies(client, &asana.AddDependenciesRequest{
+		Dependencies: format.MapToStrings(removed, func(t *asana.Task) string {
+			return t.ID
+		}),
+	}); err != nil {
+		return fmt.Errorf("failed to remove dependencies: %w", err)
+	}
+
+	opts.IO.Printf("%s %s is no longer blocked by %s\n", cs.SuccessIcon, cs.Bold(task.Name), format.List("", format.MapToStrings(removed, func(t *asana.Task) string {
+		return t.Name
+	})))
+	return nil
+}
+
+func selectDependency(opts *UndependOptions, client *asana.Client, task *asana.Task) (*asana.Task, error) {
+	current := &asana.Task{ID: task.ID}
+	if err := current.Fetch(client, &asana.Options{Fields: []string{"dependencies.name"}}); err != nil {
+		return nil, fmt.Errorf("failed to fetch dependencies: %w", err)
+	}
+	if len(current.Dependencies) == 0 {
+		return nil, fmt.Errorf("%q has no dependencies", task.Name)
+	}
+
+	names := format.MapToStrings(current.Dependencies, func(t *asana.Task) string {
+		return t.Name
+	})
+
+	index, err := opts.Prompter.Select("Select a dependency to remove:", names)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select dependency: %w", err)
+	}
+
+	return current.Dependencies[index], nil
+}
@@ -0,0 +1,94 @@
+package undepend
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/internal/prompter"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+	"github.com/timwehrle/asana/pkg/iostreams"
+)
+
+func TestNewCmdUndepend(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		wantTask string
+		wantOn   []string
+	}{
+		{
+			name:     "task and dependencies",
+			args:     []string{"100", "--on", "200,300"},
+			wantTask: "100",
+			wantOn:   []string{"200", "300"},
+		},
+		{
+			name: "no flags",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, _, _ := factory.NewTestFactory()
+
+			var got *UndependOptions
+			cmd := NewCmdUndepend(f, func(opts *UndependOptions) error {
+				got = opts
+				return nil
+			})
+			cmd.SetArgs(tt.args)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			require.NoError(t, cmd.Execute())
+			assert.Equal(t, tt.wantTask, got.Task)
+			assert.Equal(t, tt.wantOn, got.On)
+		})
+	}
+}
+
+func TestRunUndepend_Select(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://app.asana.com").
+		Get("/api/1.0/tasks/100").
+		MatchParam("opt_fields", "dependencies.name").
+		Reply(200).
+		JSON(map[string]any{"data": map[string]any{"gid": "100", "dependencies": []map[string]any{
+			{"gid": "200", "name": "Review"},
+			{"gid": "300", "name": "Design"},
+		}}})
+	gock.New("https://app.asana.com").
+		Get("/api/1.0/tasks/100").
+		Reply(200).
+		JSON(map[string]any{"data": map[string]any{"gid": "100", "name": "Ship"}})
+	gock.New("https://app.asana.com").
+		Post("/api/1.0/tasks/100/removeDependencies").
+		BodyString(`"dependencies":\["300"\]`).
+		Reply(200).
+		JSON(map[string]any{"data": map[string]any{}})
+
+	p := prompter.NewMockPrompter()
+	p.On("Select", "Select a dependency to remove:", []string{"Review", "Design"}).Return(1, nil)
+
+	ios, _, stdout, _ := iostreams.Test()
+	opts := &UndependOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       ios,
+			Prompter: p,
+			Client: func() (*asana.Client, error) {
+				return asana.NewClient(http.DefaultClient), nil
+			},
+		},
+		Task: "100",
+	}
+
+	require.NoError(t, runUndepend(opts))
+	assert.True(t, gock.IsDone())
+	assert.Contains(t, stdout.String(), "Ship is no longer blocked by Design")
+}
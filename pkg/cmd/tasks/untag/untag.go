@@ -0,0 +1,98 @@
+package untag
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/tags/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+	"github.com/timwehrle/asana/pkg/format"
+)
+
+type UntagOptions struct {
+	cmdutils.BaseOptions
+
+	Task string
+	Tags []string
+}
+
+func NewCmdUntag(f factory.Factory, runF func(*UntagOptions) error) *cobra.Command {
+	opts := &UntagOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:        f.IOStreams,
+			Prompter:  f.Prompter,
+			Config:    f.Config,
+			Client:    f.Client,
+			GitClient: f.GitClient,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "untag [<task>] <tag>...",
+		Short: "Remove tags from a task",
+		Long: heredoc.Doc(`
+				Remove one or more tags from a task. Tags are matched by name, regardless
+				of case, or by ID. The tags themselves are kept.
+
+				When the first of several arguments is a task ID or URL, it names the task.
+				Otherwise the task of the current git branch is used, or you are prompted
+				to select one.
+			`),
+		Example: heredoc.Doc(`
+				$ asana tasks untag 1204567890123 urgent
+				$ asana tasks untag urgent
+			`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if _, err := cmdutils.ParseTaskRef(args[0]); err == nil && len(args) > 1 {
+				opts.Task, args = args[0], args[1:]
+			}
+			opts.Tags = args
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runUntag(opts)
+		},
+	}
+
+	return cmd
+}
+
+func runUntag(opts *UntagOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	task, err := cmdutils.ResolveTask(&opts.BaseOptions, client, opts.Task, "Select a task to untag:")
+	if err != nil {
+		return err
+	}
+
+	tags, missing := shared.FindTags(task.Tags, opts.Tags)
+	for _, name := range missing {
+		opts.IO.ErrPrintf("%s %s has no tag %q\n", cs.WarningIcon, task.Name, name)
+	}
+	if len(tags) == 0 {
+		return fmt.Errorf("none of the tags are on task %s", task.Name)
+	}
+
+	for _, tag := range tags {
+		if err := task.RemoveTag(client, tag.ID); err != nil {
+			return fmt.Errorf("failed to remove tag %s: %w", tag.Name, err)
+		}
+	}
+
+	names := format.MapToStrings(tags, func(t *asana.Tag) string {
+		return t.Name
+	})
+	opts.IO.Printf("%s Removed %s from %s\n", cs.SuccessIcon, format.List("", names), cs.Bold(task.Name))
+	return nil
+}
@@ -5,16 +5,15 @@ import (
 	"strings"
 	"time"
 
-	"github.com/timwehrle/asana/internal/config"
-	"github.com/timwehrle/asana/internal/prompter"
-
 	"github.com/MakeNowJust/heredoc"
 	"github.com/spf13/cobra"
 	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmdutils"
 	"github.com/timwehrle/asana/pkg/convert"
 	"github.com/timwehrle/asana/pkg/factory"
 	"github.com/timwehrle/asana/pkg/format"
 	"github.com/timwehrle/asana/pkg/iostreams"
+	"github.com/timwehrle/asana/pkg/markdown"
 )
 
 type UpdateAction int
@@ -41,30 +40,49 @@ var availableActions = []taskAction{
 }
 
 type UpdateOptions struct {
-	IO       *iostreams.IOStreams
-	Prompter prompter.Prompter
+	cmdutils.BaseOptions
 
-	Config func() (*config.Config, error)
-	Client func() (*asana.Client, error)
+	Task     string
+	Markdown *bool
 }
 
 func NewCmdUpdate(f factory.Factory, runF func(*UpdateOptions) error) *cobra.Command {
 	opts := &UpdateOptions{
-		IO:       f.IOStreams,
-		Prompter: f.Prompter,
-		Config:   f.Config,
-		Client:   f.Client,
+		BaseOptions: cmdutils.BaseOptions{
+			IO:        f.IOStreams,
+			Prompter:  f.Prompter,
+			Config:    f.Config,
+			Client:    f.Client,
+			GitClient: f.GitClient,
+		},
 	}
 
+	var useMarkdown bool
+
 	cmd := &cobra.Command{
-		Use:   "update",
+		Use:   "update [<task>]",
 		Short: "Update details of a specific task",
-		Long:  "Retrieve task details and select one for updating it.",
-		Args:  cobra.NoArgs,
+		Long: heredoc.Doc(`
+			Retrieve task details and select one for updating it.
+
+			The task can be given as an ID or URL. Without one, the task encoded in
+			the current git branch name is used, or you are asked to select one.
+
+			With --markdown, the description is edited as Markdown and saved as rich
+			text. Set "asana config set markdown" to make this the default.`),
+		Args: cobra.MaximumNArgs(1),
 		Example: heredoc.Doc(`
 			$ asana tasks update
+			$ asana tasks update 1204567890123
 			$ asana ts update`),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Task = args[0]
+			}
+			if cmd.Flags().Changed("markdown") {
+				opts.Markdown = &useMarkdown
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -73,6 +91,8 @@ func NewCmdUpdate(f factory.Factory, runF func(*UpdateOptions) error) *cobra.Com
 		},
 	}
 
+	cmd.Flags().BoolVar(&useMarkdown, "markdown", false, "Edit the description as Markdown")
+
 	return cmd
 }
 
@@ -95,44 +115,12 @@ func runUpdate(opts *UpdateOptions) error {
 }
 
 func selectTask(opts *UpdateOptions) (*asana.Task, error) {
-	cfg, err := opts.Config()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get config: %w", err)
-	}
-
 	client, err := opts.Client()
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Asana client: %w", err)
 	}
 
-	tasks, _, err := client.QueryTasks(&asana.TaskQuery{
-		Assignee:       "me",
-		Workspace:      cfg.Workspace.ID,
-		CompletedSince: "now",
-	}, &asana.Options{
-		Fields: []string{"name", "due_on"},
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to query tasks: %w", err)
-	}
-
-	if len(tasks) == 0 {
-		fmt.Fprintln(opts.IO.Out, "No tasks found.")
-		return nil, nil
-	}
-
-	taskNames := format.Tasks(tasks)
-	index, err := opts.Prompter.Select("Select the task to update:", taskNames)
-	if err != nil {
-		return nil, fmt.Errorf("failed to select task: %w", err)
-	}
-
-	selectedTask := tasks[index]
-	if err := selectedTask.Fetch(client); err != nil {
-		return nil, fmt.Errorf("failed to fetch task details: %w", err)
-	}
-
-	return selectedTask, nil
+	return cmdutils.ResolveTask(&opts.BaseOptions, client, opts.Task, "Select the task to update:")
 }
 
 func selectAction(opts *UpdateOptions) (UpdateAction, error) {
@@ -231,6 +219,15 @@ func editTaskDescription(
 	task *asana.Task,
 	cs *iostreams.ColorScheme,
 ) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	if cmdutils.UseMarkdown(opts.Markdown, cfg) {
+		return editTaskDescriptionMarkdown(opts, client, task, cs)
+	}
+
 	existingDescription := strings.TrimSpace(task.Notes)
 	newDescription, err := opts.Prompter.Editor("Edit the description:", existingDescription)
 	if err != nil {
@@ -257,6 +254,58 @@ func editTaskDescription(
 	return nil
 }
 
+// editTaskDescriptionMarkdown round-trips the rich text description through
+// the editor as Markdown, keeping formatting and mentions intact.
+func editTaskDescriptionMarkdown(
+	opts *UpdateOptions,
+	client *asana.Client,
+	task *asana.Task,
+	cs *iostreams.ColorScheme,
+) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	if err := task.Fetch(client, &asana.Options{Fields: []string{"html_notes"}}); err != nil {
+		return fmt.Errorf("failed to fetch task description: %w", err)
+	}
+
+	existingDescription, err := markdown.FromHTML(task.HTMLNotes, cmdutils.MentionNames(client, cfg))
+	if err != nil {
+		return err
+	}
+
+	newDescription, err := opts.Prompter.Editor("Edit the description:", existingDescription)
+	if err != nil {
+		return fmt.Errorf("failed to get input: %w", err)
+	}
+
+	newDescription = strings.TrimSpace(newDescription)
+	if newDescription == existingDescription {
+		fmt.Fprintf(opts.IO.Out, "%s No changes made to description\n", cs.WarningIcon)
+		return nil
+	}
+
+	htmlNotes, err := markdown.ToHTML(newDescription, cmdutils.MentionResolver(client, cfg))
+	if err != nil {
+		return fmt.Errorf("failed to convert description: %w", err)
+	}
+
+	updateRequest := &asana.UpdateTaskRequest{
+		TaskBase: asana.TaskBase{
+			HTMLNotes: htmlNotes,
+		},
+	}
+
+	if err = task.Update(client, updateRequest); err != nil {
+		return fmt.Errorf("failed to update task description: %w", err)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "%s Description updated\n", cs.SuccessIcon)
+	return nil
+}
+
 func setTaskDueDate(
 	opts *UpdateOptions,
 	client *asana.Client,
@@ -1,13 +1,13 @@
 package view
 
 import (
+	"encoding/json"
 	"fmt"
+	"sync"
 	"time"
 
-	"github.com/timwehrle/asana/internal/config"
-	"github.com/timwehrle/asana/internal/prompter"
-
 	"github.com/MakeNowJust/heredoc"
+	"github.com/timwehrle/asana/pkg/cmdutils"
 	"github.com/timwehrle/asana/pkg/factory"
 	"github.com/timwehrle/asana/pkg/format"
 	"github.com/timwehrle/asana/pkg/iostreams"
@@ -17,31 +17,56 @@ import (
 )
 
 type ViewOptions struct {
-	IO       *iostreams.IOStreams
-	Prompter prompter.Prompter
+	cmdutils.BaseOptions
+
+	Task     string
+	Comments bool
+	Activity bool
+	JSON     bool
+}
 
-	Config func() (*config.Config, error)
-	Client func() (*asana.Client, error)
+// taskDetails is a task together with the related resources shown by view.
+type taskDetails struct {
+	*asana.Task
+
+	Subtasks    []*asana.Task       `json:"subtasks"`
+	Attachments []*asana.Attachment `json:"attachments"`
+	Comments    []*asana.Story      `json:"comments,omitempty"`
+	Activity    []*asana.Story      `json:"activity,omitempty"`
 }
 
 func NewCmdView(f factory.Factory, runF func(*ViewOptions) error) *cobra.Command {
 	opts := &ViewOptions{
-		IO:       f.IOStreams,
-		Prompter: f.Prompter,
-		Config:   f.Config,
-		Client:   f.Client,
+		BaseOptions: cmdutils.BaseOptions{
+			IO:        f.IOStreams,
+			Prompter:  f.Prompter,
+			Config:    f.Config,
+			Client:    f.Client,
+			GitClient: f.GitClient,
+		},
 	}
 
 	cmd := &cobra.Command{
-		Use:   "view",
+		Use:   "view [<task>]",
 		Short: "View details of a specific task",
 		Example: heredoc.Doc(`
 				$ asana tasks view
+				$ asana tasks view 1204567890123 --comments
+				$ asana tasks view --activity --json
 				$ asana ts view`),
 		Long: heredoc.Doc(`
-				Display detailed information about a specific task, allowing you to
-				analyze and manage it effectively.`),
+				Display detailed information about a specific task, including its
+				assignee, projects and sections, custom fields, subtasks, dependencies,
+				attachments and followers.
+
+				The task can be given as an ID or URL. Without one, the task encoded in
+				the current git branch name is used, or you are asked to select one.`),
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Task = args[0]
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -50,78 +75,288 @@ func NewCmdView(f factory.Factory, runF func(*ViewOptions) error) *cobra.Command
 		},
 	}
 
+	cmd.Flags().BoolVarP(&opts.Comments, "comments", "c", false, "Show comments")
+	cmd.Flags().BoolVar(&opts.Activity, "activity", false, "Show the activity log")
+	cmd.Flags().BoolVar(&opts.JSON, "json", false, "Output as JSON")
+
 	return cmd
 }
 
 func viewRun(opts *ViewOptions) error {
-	cfg, err := opts.Config()
+	client, err := opts.Client()
 	if err != nil {
 		return err
 	}
 
-	client, err := opts.Client()
+	selectMessage := fmt.Sprintf(
+		"Your Tasks on %s (Select one for more details):",
+		time.Now().Format("Jan 02, 2006"),
+	)
+
+	selectedTask, err := cmdutils.ResolveTask(&opts.BaseOptions, client, opts.Task, selectMessage)
 	if err != nil {
 		return err
 	}
 
-	allTasks, _, err := client.QueryTasks(&asana.TaskQuery{
-		Assignee:       "me",
-		Workspace:      cfg.Workspace.ID,
-		CompletedSince: "now",
-	}, &asana.Options{
-		Fields: []string{"due_on", "name"},
-	})
+	details, err := fetchDetails(client, selectedTask, opts.Comments || opts.Activity)
 	if err != nil {
 		return err
 	}
+	if !opts.Comments {
+		details.Comments = nil
+	}
+	if !opts.Activity {
+		details.Activity = nil
+	}
 
-	selectedTask, err := prompt(allTasks, opts.Prompter)
-	if err != nil {
-		return err
+	if opts.JSON {
+		enc := json.NewEncoder(opts.IO.Out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(details)
 	}
 
-	err = displayDetails(client, selectedTask, opts.IO)
-	if err != nil {
+	displayDetails(details, opts.IO)
+
+	return nil
+}
+
+// fetchDetails loads the resources related to task concurrently.
+func fetchDetails(client *asana.Client, task *asana.Task, withStories bool) (*taskDetails, error) {
+	details := &taskDetails{Task: task}
+	related := &asana.Task{ID: task.ID}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs []error
+
+	run := func(what string, fn func() error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := fn(); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("failed to fetch %s: %w", what, err))
+				mu.Unlock()
+			}
+		}()
+	}
+
+	// Rich text and the names of dependencies are not part of the default
+	// task representation.
+	run("dependencies", func() error {
+		return related.Fetch(client, &asana.Options{Fields: []string{
+			"html_notes",
+			"dependencies.name", "dependencies.completed",
+			"dependents.name", "dependents.completed",
+		}})
+	})
+
+	run("subtasks", func() (err error) {
+		details.Subtasks, err = cmdutils.AllSubtasks(client, task, "name", "completed", "due_on", "assignee.name")
+		return err
+	})
+
+	run("attachments", func() (err error) {
+		details.Attachments, err = cmdutils.AllAttachments(client, task)
 		return err
+	})
+
+	if withStories {
+		run("stories", func() error {
+			stories, err := cmdutils.AllStories(client, task, cmdutils.StoryFields...)
+			if err != nil {
+				return err
+			}
+			for _, story := range stories {
+				if cmdutils.IsComment(story) {
+					details.Comments = append(details.Comments, story)
+				} else {
+					details.Activity = append(details.Activity, story)
+				}
+			}
+			return nil
+		})
 	}
 
-	return nil
+	wg.Wait()
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+
+	if related.HTMLNotes != "" {
+		task.HTMLNotes = related.HTMLNotes
+	}
+	task.Dependencies = related.Dependencies
+	task.Dependents = related.Dependents
+
+	return details, nil
 }
 
-func prompt(allTasks []*asana.Task, prompter prompter.Prompter) (*asana.Task, error) {
-	taskNames := format.Tasks(allTasks)
+func displayDetails(d *taskDetails, io *iostreams.IOStreams) {
+	cs := io.ColorScheme()
+	task := d.Task
 
-	today := time.Now()
-	selectMessage := fmt.Sprintf(
-		"Your Tasks on %s (Select one for more details):",
-		today.Format("Jan 02, 2006"),
-	)
+	title := cs.Bold(task.Name)
+	if task.Completed != nil && *task.Completed {
+		title = fmt.Sprintf("%s %s", cs.SuccessIcon, title)
+	}
+	fmt.Fprintln(io.Out, title)
+	if task.PermalinkURL != "" {
+		fmt.Fprintln(io.Out, cs.Gray(task.PermalinkURL))
+	}
+	fmt.Fprintln(io.Out)
 
-	index, err := prompter.Select(selectMessage, taskNames)
-	if err != nil {
-		return nil, err
+	assignee := "None"
+	if task.Assignee != nil {
+		assignee = task.Assignee.Name
+	}
+
+	field := func(label, value string) {
+		fmt.Fprintf(io.Out, "%s %s\n", cs.Gray(fmt.Sprintf("%-11s", label+":")), value)
+	}
+
+	field("Assignee", assignee)
+	field("Due", format.Date(task.DueOn))
+	if task.StartOn != nil {
+		field("Start", format.Date(task.StartOn))
+	}
+	field("Projects", memberships(task))
+	field("Tags", format.List("", format.MapToStrings(task.Tags, func(t *asana.Tag) string {
+		return t.Name
+	})))
+	field("Followers", users(task.Followers))
+
+	if notes := format.RichText(task.HTMLNotes, task.Notes, io); notes != "" {
+		section(io, "Description")
+		fmt.Fprintln(io.Out, notes)
+	}
+
+	if len(task.CustomFields) > 0 {
+		section(io, "Custom fields")
+		width := 0
+		for _, f := range task.CustomFields {
+			width = max(width, len(f.Name))
+		}
+		for _, f := range task.CustomFields {
+			fmt.Fprintf(io.Out, "  %s %s\n",
+				cs.Gray(fmt.Sprintf("%-*s", width+1, f.Name+":")),
+				format.CustomFieldValue(f))
+		}
+	}
+
+	if len(d.Subtasks) > 0 {
+		done := 0
+		for _, t := range d.Subtasks {
+			if t.Completed != nil && *t.Completed {
+				done++
+			}
+		}
+		section(io, fmt.Sprintf("Subtasks (%d/%d completed)", done, len(d.Subtasks)))
+		taskList(io, d.Subtasks)
+	}
+
+	if len(task.Dependencies) > 0 {
+		section(io, "Blocked by")
+		taskList(io, task.Dependencies)
+	}
+
+	if len(task.Dependents) > 0 {
+		section(io, "Blocking")
+		taskList(io, task.Dependents)
+	}
+
+	if len(d.Attachments) > 0 {
+		section(io, "Attachments")
+		for _, a := range d.Attachments {
+			info := a.Host
+			if a.Size != nil {
+				info = format.Bytes(*a.Size)
+			}
+			fmt.Fprintf(io.Out, "  %s %s\n", a.Name, cs.Gray("("+info+")"))
+		}
 	}
 
-	return allTasks[index], nil
+	if len(d.Comments) > 0 {
+		section(io, fmt.Sprintf("Comments (%d)", len(d.Comments)))
+		for i, story := range d.Comments {
+			if i > 0 {
+				fmt.Fprintln(io.Out)
+			}
+			fmt.Fprintf(io.Out, "  %s %s\n", cs.Bold(author(story)), cs.Gray("· "+storyDate(story)))
+			fmt.Fprintln(io.Out, format.IndentedRichText(story.HTMLText, story.Text, io, "  "))
+		}
+	}
+
+	if len(d.Activity) > 0 {
+		section(io, "Activity")
+		for _, story := range d.Activity {
+			fmt.Fprintf(io.Out, "  %s %s %s\n", author(story), story.Text, cs.Gray("· "+storyDate(story)))
+		}
+	}
+}
+
+func section(io *iostreams.IOStreams, title string) {
+	fmt.Fprintf(io.Out, "\n%s\n", io.ColorScheme().Bold(title))
 }
 
-func displayDetails(client *asana.Client, task *asana.Task, io *iostreams.IOStreams) error {
+func taskList(io *iostreams.IOStreams, tasks []*asana.Task) {
 	cs := io.ColorScheme()
 
-	err := task.Fetch(client)
-	if err != nil {
-		return err
+	for _, t := range tasks {
+		icon := "○"
+		if t.Completed != nil && *t.Completed {
+			icon = cs.SuccessIcon
+		}
+
+		line := fmt.Sprintf("  %s %s", icon, t.Name)
+		if t.Assignee != nil && t.Assignee.Name != "" {
+			line += cs.Gray(" @" + t.Assignee.Name)
+		}
+		if t.DueOn != nil {
+			line += cs.Gray(" · " + format.Date(t.DueOn))
+		}
+		fmt.Fprintln(io.Out, line)
 	}
+}
 
-	fmt.Fprintf(
-		io.Out,
-		"%s | Due: %s | %s\n",
-		cs.Bold(task.Name),
-		format.Date(task.DueOn),
-		format.Projects(task.Projects),
-	)
-	fmt.Fprintf(io.Out, "%s\n", format.Tags(task.Tags))
-	fmt.Fprintln(io.Out, format.Notes(task.Notes))
+func memberships(task *asana.Task) string {
+	if len(task.Memberships) == 0 {
+		return format.List("", format.MapToStrings(task.Projects, func(p *asana.Project) string {
+			return p.Name
+		}))
+	}
 
-	return nil
+	parts := make([]string, 0, len(task.Memberships))
+	for _, m := range task.Memberships {
+		if m.Project == nil {
+			continue
+		}
+		part := m.Project.Name
+		if m.Section != nil && m.Section.Name != "" {
+			part += " › " + m.Section.Name
+		}
+		parts = append(parts, part)
+	}
+
+	return format.List("", parts)
+}
+
+func users(list []*asana.User) string {
+	return format.List("", format.MapToStrings(list, func(u *asana.User) string {
+		return u.Name
+	}))
+}
+
+func author(story *asana.Story) string {
+	if story.CreatedBy == nil || story.CreatedBy.Name == "" {
+		return "Asana"
+	}
+	return story.CreatedBy.Name
+}
+
+func storyDate(story *asana.Story) string {
+	if story.CreatedAt == nil {
+		return ""
+	}
+	return format.HumanDate(story.CreatedAt.Local())
 }
@@ -0,0 +1,106 @@
+package adduser
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/teams/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type AddUserOptions struct {
+	cmdutils.BaseOptions
+
+	Team  string
+	Users []string
+}
+
+func NewCmdAddUser(f factory.Factory, runF func(*AddUserOptions) error) *cobra.Command {
+	opts := &AddUserOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "add-user <team> <user>...",
+		Short: "Add users to a team",
+		Long: heredoc.Doc(`
+				Add one or more users of the workspace to a team. Users are given by
+				name, email, ID or 'me'. Users already in the team are skipped.
+			`),
+		Example: heredoc.Doc(`
+				$ asana teams add-user Engineering jane@example.com
+				$ asana teams add-user 1204567890123 "John Doe" me
+			`),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Team = args[0]
+			opts.Users = args[1:]
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runAddUser(opts)
+		},
+	}
+
+	return cmd
+}
+
+func runAddUser(opts *AddUserOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	team, err := cmdutils.ResolveTeam(client, cfg, opts.Prompter, opts.Team)
+	if err != nil {
+		return err
+	}
+
+	users := make([]*asana.User, 0, len(opts.Users))
+	for _, nameOrID := range opts.Users {
+		user, err := cmdutils.ResolveUser(client, cfg, nameOrID)
+		if err != nil {
+			return err
+		}
+		users = append(users, user)
+	}
+
+	memberships, err := team.AllMemberships(client, &asana.Options{Fields: shared.MembershipFields})
+	if err != nil {
+		return fmt.Errorf("failed to fetch team members: %w", err)
+	}
+
+	for _, user := range users {
+		if shared.FindMembership(memberships, user.ID) != nil {
+			opts.IO.ErrPrintf("%s %s is already a member of %s\n", cs.WarningIcon, user.Name, team.Name)
+			continue
+		}
+
+		membership, err := team.AddUser(client, user.ID)
+		if err != nil {
+			return fmt.Errorf("failed to add %s to team: %w", user.Name, err)
+		}
+		memberships = append(memberships, membership)
+
+		opts.IO.Printf("%s Added %s to %s\n", cs.SuccessIcon, cs.Bold(user.Name), cs.Bold(team.Name))
+	}
+
+	return nil
+}
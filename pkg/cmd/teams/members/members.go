@@ -0,0 +1,112 @@
+package members
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/teams/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type MembersOptions struct {
+	cmdutils.BaseOptions
+
+	Team string
+	JSON bool
+}
+
+func NewCmdMembers(f factory.Factory, runF func(*MembersOptions) error) *cobra.Command {
+	opts := &MembersOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "members [<team>]",
+		Short: "List the members of a team",
+		Long: heredoc.Doc(`
+				List the members of a team with their email address, marking admins,
+				guests and members with limited access.
+			`),
+		Example: heredoc.Doc(`
+				$ asana teams members Engineering
+				$ asana teams members 1204567890123 --json
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Team = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runMembers(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.JSON, "json", false, "Output members as JSON")
+
+	return cmd
+}
+
+func runMembers(opts *MembersOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	team, err := cmdutils.ResolveTeam(client, cfg, opts.Prompter, opts.Team)
+	if err != nil {
+		return err
+	}
+
+	memberships, err := team.AllMemberships(client, &asana.Options{Fields: shared.MembershipFields})
+	if err != nil {
+		return fmt.Errorf("failed to fetch team members: %w", err)
+	}
+
+	if opts.JSON {
+		enc := json.NewEncoder(opts.IO.Out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(memberships)
+	}
+
+	opts.IO.Printf("\nMembers of %s:\n\n", cs.Bold(team.Name))
+	if len(memberships) == 0 {
+		opts.IO.Println("No members found")
+		return nil
+	}
+
+	for i, m := range memberships {
+		if m.User == nil {
+			continue
+		}
+		line := fmt.Sprintf("%2d. %s", i+1, cs.Bold(m.User.Name))
+		if m.User.Email != "" {
+			line += " " + cs.Gray("<"+m.User.Email+">")
+		}
+		if role := shared.Role(m); role != "" {
+			line += " " + cs.Gray("("+role+")")
+		}
+		opts.IO.Println(line)
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package projects
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	statusshared "github.com/timwehrle/asana/pkg/cmd/projects/status/shared"
+	"github.com/timwehrle/asana/pkg/cmd/teams/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+)
+
+type ProjectsOptions struct {
+	cmdutils.BaseOptions
+
+	Team     string
+	Archived bool
+	JSON     bool
+}
+
+func NewCmdProjects(f factory.Factory, runF func(*ProjectsOptions) error) *cobra.Command {
+	opts := &ProjectsOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "projects [<team>]",
+		Short: "List the projects of a team",
+		Long: heredoc.Doc(`
+				List the active projects of a team with their owner and latest status.
+				Archived projects are only listed with --archived.
+			`),
+		Example: heredoc.Doc(`
+				$ asana teams projects Engineering
+				$ asana teams projects Engineering --archived --json
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Team = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runProjects(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.Archived, "archived", "a", false, "Include archived projects")
+	cmd.Flags().BoolVar(&opts.JSON, "json", false, "Output projects as JSON")
+
+	return cmd
+}
+
+func runProjects(opts *ProjectsOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	team, err := cmdutils.ResolveTeam(client, cfg, opts.Prompter, opts.Team)
+	if err != nil {
+		return err
+	}
+
+	all, err := team.AllProjects(client, &asana.Options{Fields: shared.ProjectFields})
+	if err != nil {
+		return fmt.Errorf("failed to fetch team projects: %w", err)
+	}
+
+	projects := make([]*asana.Project, 0, len(all))
+	for _, p := range all {
+		if opts.Archived || !asana.IsTrue(p.Archived) {
+			projects = append(projects, p)
+		}
+	}
+
+	if opts.JSON {
+		enc := json.NewEncoder(opts.IO.Out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(projects)
+	}
+
+	opts.IO.Printf("\nProjects of %s:\n\n", cs.Bold(team.Name))
+	if len(projects) == 0 {
+		opts.IO.Println("No projects found")
+		return nil
+	}
+
+	for i, p := range projects {
+		line := fmt.Sprintf("%2d. %s", i+1, cs.Bold(p.Name))
+		if p.CurrentStatusUpdate != nil {
+			line += " " + statusshared.Badge(opts.IO, p.CurrentStatusUpdate.StatusType)
+		}
+		if p.Owner != nil {
+			line += cs.Gray(" @" + p.Owner.Name)
+		}
+		if asana.IsTrue(p.Archived) {
+			line += " " + cs.Gray("(archived)")
+		}
+		opts.IO.Println(line)
+	}
+
+	return nil
+}
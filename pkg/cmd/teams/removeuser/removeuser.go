@@ -0,0 +1,125 @@
+package removeuser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/cmd/teams/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+	"github.com/timwehrle/asana/pkg/format"
+)
+
+type RemoveUserOptions struct {
+	cmdutils.BaseOptions
+
+	Team  string
+	Users []string
+	Yes   bool
+}
+
+func NewCmdRemoveUser(f factory.Factory, runF func(*RemoveUserOptions) error) *cobra.Command {
+	opts := &RemoveUserOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "remove-user <team> <user>...",
+		Short: "Remove users from a team",
+		Long: heredoc.Doc(`
+				Remove one or more users from a team. Users are given by name, email, ID
+				or 'me'. Users who are not in the team are skipped.
+			`),
+		Example: heredoc.Doc(`
+				$ asana teams remove-user Engineering jane@example.com
+				$ asana teams remove-user 1204567890123 "John Doe" --yes
+			`),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Team = args[0]
+			opts.Users = args[1:]
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runRemoveUser(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func runRemoveUser(opts *RemoveUserOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	team, err := cmdutils.ResolveTeam(client, cfg, opts.Prompter, opts.Team)
+	if err != nil {
+		return err
+	}
+
+	memberships, err := team.AllMemberships(client, &asana.Options{Fields: shared.MembershipFields})
+	if err != nil {
+		return fmt.Errorf("failed to fetch team members: %w", err)
+	}
+
+	var users []*asana.User
+	for _, nameOrID := range opts.Users {
+		user, err := cmdutils.ResolveUser(client, cfg, nameOrID)
+		if err != nil {
+			return err
+		}
+		if shared.FindMembership(memberships, user.ID) == nil {
+			opts.IO.ErrPrintf("%s %s is not a member of %s\n", cs.WarningIcon, user.Name, team.Name)
+			continue
+		}
+		users = append(users, user)
+	}
+	if len(users) == 0 {
+		return nil
+	}
+
+	names := format.MapToStrings(users, func(u *asana.User) string {
+		return u.Name
+	})
+
+	if !opts.Yes {
+		confirmed, err := opts.Prompter.Confirm(
+			fmt.Sprintf("Remove %s from team %s?", strings.Join(names, ", "), team.Name), "No")
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return nil
+		}
+	}
+
+	for _, user := range users {
+		if err := team.RemoveUser(client, user.ID); err != nil {
+			return fmt.Errorf("failed to remove %s from team: %w", user.Name, err)
+		}
+		opts.IO.Printf("%s Removed %s from %s\n", cs.SuccessIcon, cs.Bold(user.Name), cs.Bold(team.Name))
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package shared
+
+import (
+	"github.com/timwehrle/asana/internal/api/asana"
+)
+
+// MembershipFields are the team membership fields shown by the team commands.
+var MembershipFields = []string{
+	"user.name", "user.email", "is_admin", "is_guest", "is_limited_access",
+}
+
+// ProjectFields are the project fields shown by the team commands.
+var ProjectFields = []string{
+	"name", "archived", "owner.name", "current_status_update.status_type",
+}
+
+// Role describes the role of a team member, or returns "" for regular members.
+func Role(m *asana.TeamMembership) string {
+	switch {
+	case m.IsAdmin:
+		return "admin"
+	case m.IsGuest:
+		return "guest"
+	case m.IsLimitedAccess:
+		return "limited access"
+	default:
+		return ""
+	}
+}
+
+// FindMembership returns the membership of the user with the given ID, or nil.
+func FindMembership(memberships []*asana.TeamMembership, userID string) *asana.TeamMembership {
+	for _, m := range memberships {
+		if m.User != nil && m.User.ID == userID {
+			return m
+		}
+	}
+	return nil
+}
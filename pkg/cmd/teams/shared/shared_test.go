@@ -0,0 +1,23 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/timwehrle/asana/internal/api/asana"
+)
+
+func TestRole(t *testing.T) {
+	assert.Equal(t, "admin", Role(&asana.TeamMembership{IsAdmin: true, IsLimitedAccess: true}))
+	assert.Equal(t, "guest", Role(&asana.TeamMembership{IsGuest: true}))
+	assert.Equal(t, "limited access", Role(&asana.TeamMembership{IsLimitedAccess: true}))
+	assert.Equal(t, "", Role(&asana.TeamMembership{}))
+}
+
+func TestFindMembership(t *testing.T) {
+	ann := &asana.TeamMembership{User: &asana.User{ID: "1"}}
+	memberships := []*asana.TeamMembership{{}, ann}
+
+	assert.Same(t, ann, FindMembership(memberships, "1"))
+	assert.Nil(t, FindMembership(memberships, "2"))
+}
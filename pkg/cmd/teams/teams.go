@@ -2,7 +2,12 @@ package teams
 
 import (
 	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/pkg/cmd/teams/adduser"
 	"github.com/timwehrle/asana/pkg/cmd/teams/list"
+	"github.com/timwehrle/asana/pkg/cmd/teams/members"
+	"github.com/timwehrle/asana/pkg/cmd/teams/projects"
+	"github.com/timwehrle/asana/pkg/cmd/teams/removeuser"
+	"github.com/timwehrle/asana/pkg/cmd/teams/view"
 	"github.com/timwehrle/asana/pkg/factory"
 )
 
@@ -14,6 +19,11 @@ func NewCmdTeams(f factory.Factory) *cobra.Command {
 	}
 
 	cmd.AddCommand(list.NewCmdList(f, nil))
+	cmd.AddCommand(view.NewCmdView(f, nil))
+	cmd.AddCommand(members.NewCmdMembers(f, nil))
+	cmd.AddCommand(projects.NewCmdProjects(f, nil))
+	cmd.AddCommand(adduser.NewCmdAddUser(f, nil))
+	cmd.AddCommand(removeuser.NewCmdRemoveUser(f, nil))
 
 	return cmd
 }
@@ -0,0 +1,159 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/api/asana"
+	statusshared "github.com/timwehrle/asana/pkg/cmd/projects/status/shared"
+	"github.com/timwehrle/asana/pkg/cmd/teams/shared"
+	"github.com/timwehrle/asana/pkg/cmdutils"
+	"github.com/timwehrle/asana/pkg/factory"
+	"github.com/timwehrle/asana/pkg/format"
+	"github.com/timwehrle/asana/pkg/iostreams"
+)
+
+type ViewOptions struct {
+	cmdutils.BaseOptions
+
+	Team string
+	JSON bool
+}
+
+// teamView is a team with its members and active projects.
+type teamView struct {
+	Team     *asana.Team             `json:"team"`
+	Members  []*asana.TeamMembership `json:"members"`
+	Projects []*asana.Project        `json:"projects"`
+}
+
+func NewCmdView(f factory.Factory, runF func(*ViewOptions) error) *cobra.Command {
+	opts := &ViewOptions{
+		BaseOptions: cmdutils.BaseOptions{
+			IO:       f.IOStreams,
+			Prompter: f.Prompter,
+			Config:   f.Config,
+			Client:   f.Client,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view [<team>]",
+		Short: "View a team",
+		Long: heredoc.Doc(`
+				Show the description, members and active projects of a team.
+			`),
+		Example: heredoc.Doc(`
+				$ asana teams view Engineering
+				$ asana teams view 1204567890123 --json
+			`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Team = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runView(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.JSON, "json", false, "Output the team as JSON")
+
+	return cmd
+}
+
+func runView(opts *ViewOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	client, err := opts.Client()
+	if err != nil {
+		return fmt.Errorf("failed to initialize Asana client: %w", err)
+	}
+
+	team, err := cmdutils.ResolveTeam(client, cfg, opts.Prompter, opts.Team)
+	if err != nil {
+		return err
+	}
+	if err := team.Fetch(client); err != nil {
+		return fmt.Errorf("failed to fetch team: %w", err)
+	}
+
+	memberships, err := team.AllMemberships(client, &asana.Options{Fields: shared.MembershipFields})
+	if err != nil {
+		return fmt.Errorf("failed to fetch team members: %w", err)
+	}
+
+	all, err := team.AllProjects(client, &asana.Options{Fields: shared.ProjectFields})
+	if err != nil {
+		return fmt.Errorf("failed to fetch team projects: %w", err)
+	}
+	projects := make([]*asana.Project, 0, len(all))
+	for _, p := range all {
+		if !asana.IsTrue(p.Archived) {
+			projects = append(projects, p)
+		}
+	}
+
+	if opts.JSON {
+		enc := json.NewEncoder(opts.IO.Out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(&teamView{Team: team, Members: memberships, Projects: projects})
+	}
+
+	writeTeam(opts.IO, team, memberships, projects)
+	return nil
+}
+
+// writeTeam prints the team description followed by its members and projects.
+func writeTeam(ios *iostreams.IOStreams, team *asana.Team, memberships []*asana.TeamMembership, projects []*asana.Project) {
+	cs := ios.ColorScheme()
+
+	ios.Printf("%s %s\n", cs.Bold(team.Name), cs.Gray(team.ID))
+	if description := format.RichText(team.HTMLDescription, team.Description, ios); description != "" {
+		ios.Printf("\n%s\n", format.Indent(description, "  "))
+	}
+
+	ios.Printf("\n%s %s\n", cs.Bold("Members"), cs.Gray(fmt.Sprintf("(%d)", len(memberships))))
+	if len(memberships) == 0 {
+		ios.Println("  No members")
+	}
+	for _, m := range memberships {
+		if m.User == nil {
+			continue
+		}
+		line := "  " + m.User.Name
+		if role := shared.Role(m); role != "" {
+			line += " " + cs.Gray("("+role+")")
+		}
+		ios.Println(line)
+	}
+
+	ios.Printf("\n%s %s\n", cs.Bold("Projects"), cs.Gray(fmt.Sprintf("(%d)", len(projects))))
+	if len(projects) == 0 {
+		ios.Println("  No active projects")
+	}
+
+	width := 0
+	for _, p := range projects {
+		width = max(width, utf8.RuneCountInString(p.Name))
+	}
+	for _, p := range projects {
+		line := "  " + p.Name
+		if p.CurrentStatusUpdate != nil {
+			line += strings.Repeat(" ", width-utf8.RuneCountInString(p.Name)) + "  " +
+				statusshared.Badge(ios, p.CurrentStatusUpdate.StatusType)
+		}
+		ios.Println(line)
+	}
+}
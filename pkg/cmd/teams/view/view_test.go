@@ -0,0 +1,52 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/iostreams"
+)
+
+func TestWriteTeam(t *testing.T) {
+	ios, _, out, _ := iostreams.Test()
+
+	team := &asana.Team{ID: "5", Name: "Engineering", Description: "Builds the product"}
+	memberships := []*asana.TeamMembership{
+		{User: &asana.User{Name: "Ann"}, IsAdmin: true},
+		{User: &asana.User{Name: "Bob"}},
+	}
+
+	website := &asana.Project{}
+	website.Name = "Website"
+	website.CurrentStatusUpdate = &asana.StatusUpdate{}
+	website.CurrentStatusUpdate.StatusType = asana.StatusAtRisk
+	api := &asana.Project{}
+	api.Name = "API v2"
+
+	writeTeam(ios, team, memberships, []*asana.Project{website, api})
+
+	assert.Equal(t, "Engineering 5\n"+
+		"\n"+
+		"  Builds the product\n"+
+		"\n"+
+		"Members (2)\n"+
+		"  Ann (admin)\n"+
+		"  Bob\n"+
+		"\n"+
+		"Projects (2)\n"+
+		"  Website  At risk\n"+
+		"  API v2\n",
+		iostreams.StripGray(out.String()))
+}
+
+func TestWriteTeam_Empty(t *testing.T) {
+	ios, _, out, _ := iostreams.Test()
+
+	writeTeam(ios, &asana.Team{ID: "5", Name: "Design"}, nil, nil)
+
+	assert.Contains(t, out.String(), "  No members\n")
+	assert.Contains(t, out.String(), "  No active projects\n")
+}
+
+// stripGray removes the color codes of cs.Gray, which are always emitted.
@@ -17,6 +17,7 @@ import (
 type CreateOptions struct {
 	cmdutils.BaseOptions
 
+	Task    string
 	Minutes int
 	DateStr string
 	Date    *asana.Date
@@ -25,15 +26,16 @@ type CreateOptions struct {
 func NewCmdCreate(f factory.Factory, runF func(*CreateOptions) error) *cobra.Command {
 	opts := &CreateOptions{
 		BaseOptions: cmdutils.BaseOptions{
-			IO:       f.IOStreams,
-			Prompter: f.Prompter,
-			Config:   f.Config,
-			Client:   f.Client,
+			IO:        f.IOStreams,
+			Prompter:  f.Prompter,
+			Config:    f.Config,
+			Client:    f.Client,
+			GitClient: f.GitClient,
 		},
 	}
 
 	cmd := &cobra.Command{
-		Use:   "create",
+		Use:   "create [<task>]",
 		Short: "Log time to a task",
 		Long:  "Record a new time entry on a selected Asana task.",
 		Example: heredoc.Doc(`
@@ -43,7 +45,12 @@ func NewCmdCreate(f factory.Factory, runF func(*CreateOptions) error) *cobra.Com
 			# Log time interactively
 			asana time create --date 2025-01-06
 		`),
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Task = args[0]
+			}
+
 			if runF == nil {
 				return runCreate(opts)
 			}
@@ -86,7 +93,7 @@ func runCreate(opts *CreateOptions) error {
 		return err
 	}
 
-	task, err := cmdutils.SelectTask(&opts.BaseOptions, client)
+	task, err := cmdutils.ResolveTask(&opts.BaseOptions, client, opts.Task, "Select a task to log time to:")
 	if err != nil {
 		return err
 	}
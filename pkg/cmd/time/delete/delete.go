@@ -12,23 +12,31 @@ import (
 
 type DeleteOptions struct {
 	cmdutils.BaseOptions
+
+	Task string
 }
 
 func NewCmdDelete(f factory.Factory, runF func(*DeleteOptions) error) *cobra.Command {
 	opts := &DeleteOptions{
 		BaseOptions: cmdutils.BaseOptions{
-			IO:       f.IOStreams,
-			Prompter: f.Prompter,
-			Config:   f.Config,
-			Client:   f.Client,
+			IO:        f.IOStreams,
+			Prompter:  f.Prompter,
+			Config:    f.Config,
+			Client:    f.Client,
+			GitClient: f.GitClient,
 		},
 	}
 
 	cmd := &cobra.Command{
-		Use:   "delete",
+		Use:   "delete [<task>]",
 		Short: "Delete a time entry from a task",
 		Long:  "Delete and remove a time entry from a selected Asana task.",
+		Args:  cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Task = args[0]
+			}
+
 			if runF == nil {
 				return runDelete(opts)
 			}
@@ -48,7 +56,7 @@ func runDelete(opts *DeleteOptions) error {
 		return err
 	}
 
-	task, err := cmdutils.SelectTask(&opts.BaseOptions, client)
+	task, err := cmdutils.ResolveTask(&opts.BaseOptions, client, opts.Task, "Select a task to delete a time entry from:")
 	if err != nil {
 		return err
 	}
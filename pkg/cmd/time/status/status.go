@@ -15,20 +15,23 @@ import (
 
 type StatusOptions struct {
 	cmdutils.BaseOptions
+
+	Task string
 }
 
 func NewCmdStatus(f factory.Factory, runF func(*StatusOptions) error) *cobra.Command {
 	opts := &StatusOptions{
 		BaseOptions: cmdutils.BaseOptions{
-			IO:       f.IOStreams,
-			Prompter: f.Prompter,
-			Config:   f.Config,
-			Client:   f.Client,
+			IO:        f.IOStreams,
+			Prompter:  f.Prompter,
+			Config:    f.Config,
+			Client:    f.Client,
+			GitClient: f.GitClient,
 		},
 	}
 
 	cmd := &cobra.Command{
-		Use:   "status",
+		Use:   "status [<task>]",
 		Short: "Show tracked time for a task",
 		Long: heredoc.Doc(`
 				Display all time entries logged on a selected Asana task, grouped by date,
@@ -38,7 +41,12 @@ func NewCmdStatus(f factory.Factory, runF func(*StatusOptions) error) *cobra.Com
 				# Show the tracked time of a selected task
 				$ asana timer status
 			`),
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Task = args[0]
+			}
+
 			if runF == nil {
 				return runStatus(opts)
 			}
@@ -64,7 +72,7 @@ func runStatus(opts *StatusOptions) error {
 		return err
 	}
 
-	task, err := cmdutils.SelectTask(&opts.BaseOptions, client)
+	task, err := cmdutils.ResolveTask(&opts.BaseOptions, client, opts.Task, "Select a task to view tracked time:")
 	if err != nil {
 		return err
 	}
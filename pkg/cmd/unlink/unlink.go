@@ -0,0 +1,66 @@
+package unlink
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/timwehrle/asana/internal/config"
+	"github.com/timwehrle/asana/pkg/factory"
+	"github.com/timwehrle/asana/pkg/iostreams"
+)
+
+type UnlinkOptions struct {
+	IO *iostreams.IOStreams
+}
+
+func NewCmdUnlink(f factory.Factory, runF func(*UnlinkOptions) error) *cobra.Command {
+	opts := &UnlinkOptions{
+		IO: f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "unlink",
+		Short: "Remove the Asana project link of the current directory",
+		Long: heredoc.Docf(`
+				Delete the nearest %[1]s.asana.yml%[1]s file, searching from the current
+				directory upwards.
+			`, "`"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return runUnlink(opts)
+		},
+	}
+
+	return cmd
+}
+
+func runUnlink(opts *UnlinkOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to determine working directory: %w", err)
+	}
+
+	path, err := config.FindLinkFile(wd)
+	if err != nil {
+		return err
+	}
+	if path == "" {
+		return errors.New("this directory is not linked to an Asana project")
+	}
+
+	if err := os.Remove(path); err != nil {
+		return fmt.Errorf("failed to remove %s: %w", path, err)
+	}
+
+	opts.IO.Printf("%s Removed %s\n", cs.SuccessIcon, path)
+	return nil
+}
@@ -0,0 +1,32 @@
+package cmdutils
+
+import "github.com/timwehrle/asana/internal/api/asana"
+
+// AttachmentFields are the attachment fields needed to list attachments.
+var AttachmentFields = []string{
+	"name",
+	"host",
+	"resource_subtype",
+	"size",
+	"view_url",
+	"created_at",
+}
+
+// AllAttachments pages through all attachments of a task.
+func AllAttachments(client *asana.Client, task *asana.Task) ([]*asana.Attachment, error) {
+	var attachments []*asana.Attachment
+	options := &asana.Options{Limit: 100, Fields: AttachmentFields}
+
+	for {
+		batch, nextPage, err := task.Attachments(client, options)
+		if err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, batch...)
+
+		if nextPage == nil || nextPage.Offset == "" {
+			return attachments, nil
+		}
+		options.Offset = nextPage.Offset
+	}
+}
@@ -0,0 +1,168 @@
+package cmdutils
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/internal/config"
+	"github.com/timwehrle/asana/pkg/format"
+	"github.com/timwehrle/asana/pkg/markdown"
+)
+
+// StoryFields are the story fields needed to display comments and activity.
+var StoryFields = []string{
+	"created_at",
+	"created_by.name",
+	"resource_subtype",
+	"text",
+	"html_text",
+	"is_edited",
+	"is_pinned",
+}
+
+// IsComment reports whether a story is a user comment rather than activity.
+func IsComment(story *asana.Story) bool {
+	return story.ResourceSubtype == "comment_added"
+}
+
+// AllStories pages through all stories of a task, oldest first.
+func AllStories(client *asana.Client, task *asana.Task, fields ...string) ([]*asana.Story, error) {
+	var stories []*asana.Story
+	options := &asana.Options{Limit: 100, Fields: fields}
+
+	for {
+		batch, nextPage, err := task.Stories(client, options)
+		if err != nil {
+			return nil, err
+		}
+		stories = append(stories, batch...)
+
+		if nextPage == nil || nextPage.Offset == "" {
+			return stories, nil
+		}
+		options.Offset = nextPage.Offset
+	}
+}
+
+// CurrentUserID returns the GID of the authenticated user.
+func CurrentUserID(client *asana.Client, cfg *config.Config) (string, error) {
+	if cfg.UserID != "" {
+		return cfg.UserID, nil
+	}
+
+	me, err := client.CurrentUser()
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch current user: %w", err)
+	}
+	return me.ID, nil
+}
+
+// ReadBody returns the text of a comment or description. A message of "-",
+// or no message while stdin is not a terminal, reads from stdin. Without a
+// message the text is written in the editor, starting from existing.
+func ReadBody(opts *BaseOptions, message, prompt, existing string) (string, error) {
+	switch {
+	case message == "-" || (message == "" && !opts.IO.IsStdinTTY):
+		b, err := io.ReadAll(opts.IO.In)
+		if err != nil {
+			return "", fmt.Errorf("failed to read from stdin: %w", err)
+		}
+		message = string(b)
+	case message == "":
+		text, err := opts.Prompter.Editor(prompt, existing)
+		if err != nil {
+			return "", fmt.Errorf("failed to read input: %w", err)
+		}
+		message = text
+	}
+
+	message = strings.TrimSpace(message)
+	if message == "" {
+		return "", errors.New("text cannot be empty")
+	}
+	return message, nil
+}
+
+// CommentHTML converts the text of a comment to rich text, resolving
+// @mentions to profile links. Markdown formatting is only interpreted when
+// useMarkdown is set.
+func CommentHTML(client *asana.Client, cfg *config.Config, text string, useMarkdown bool) (string, error) {
+	mentions := MentionResolver(client, cfg)
+	if useMarkdown {
+		return markdown.ToHTML(text, mentions)
+	}
+	return markdown.TextToHTML(text, mentions)
+}
+
+// ResolveComment returns the comment with the given GID, or lets the user pick
+// one of their comments on the task referenced by taskRef. Only comments
+// written by the current user are returned.
+func ResolveComment(opts *BaseOptions, c *asana.Client, ref, taskRef, message string) (*asana.Story, error) {
+	cfg, err := opts.Config()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	me, err := CurrentUserID(c, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if ref != "" {
+		story := &asana.Story{ID: ref}
+		if err := story.Fetch(c, &asana.Options{Fields: StoryFields}); err != nil {
+			if asana.IsNotFoundError(err) {
+				return nil, fmt.Errorf("comment %s not found", ref)
+			}
+			return nil, fmt.Errorf("failed to fetch comment: %w", err)
+		}
+		if !IsComment(story) {
+			return nil, fmt.Errorf("story %s is not a comment", ref)
+		}
+		if story.CreatedBy == nil || story.CreatedBy.ID != me {
+			return nil, errors.New("you can only change your own comments")
+		}
+		return story, nil
+	}
+
+	task, err := ResolveTask(opts, c, taskRef, "Select the task of the comment:")
+	if err != nil {
+		return nil, err
+	}
+
+	stories, err := AllStories(c, task, StoryFields...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch comments: %w", err)
+	}
+
+	var own []*asana.Story
+	for _, story := range stories {
+		if IsComment(story) && story.CreatedBy != nil && story.CreatedBy.ID == me {
+			own = append(own, story)
+		}
+	}
+	if len(own) == 0 {
+		return nil, fmt.Errorf("you have no comments on %q", task.Name)
+	}
+
+	labels := format.MapToStrings(own, func(s *asana.Story) string {
+		text := []rune(strings.Join(strings.Fields(s.Text), " "))
+		if len(text) > 60 {
+			text = append(text[:57], []rune("...")...)
+		}
+		if s.CreatedAt != nil {
+			return fmt.Sprintf("%s — %s", format.RelativeTime(*s.CreatedAt), string(text))
+		}
+		return string(text)
+	})
+
+	index, err := opts.Prompter.Select(message, labels)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select comment: %w", err)
+	}
+
+	return own[index], nil
+}
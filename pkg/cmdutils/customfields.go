@@ -0,0 +1,262 @@
+package cmdutils
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/internal/config"
+	"github.com/timwehrle/asana/pkg/convert"
+	"github.com/timwehrle/asana/pkg/format"
+)
+
+// ErrLockedField explains the 403 Forbidden the API returns when setting the
+// value of a locked custom field.
+var ErrLockedField = errors.New("a custom field is locked and can only be changed by its owner")
+
+// customFieldSettingFields are the project fields needed to set the values
+// of its custom fields.
+var customFieldSettingFields = []string{
+	"custom_field_settings.custom_field.name",
+	"custom_field_settings.custom_field.resource_subtype",
+	"custom_field_settings.custom_field.format",
+	"custom_field_settings.custom_field.precision",
+	"custom_field_settings.custom_field.enum_options.name",
+	"custom_field_settings.custom_field.enum_options.enabled",
+}
+
+// ProjectCustomFields returns the custom fields of the given projects, each
+// field once.
+func ProjectCustomFields(client *asana.Client, projects []*asana.Project) ([]*asana.CustomField, error) {
+	var fields []*asana.CustomField
+	seen := make(map[string]bool)
+
+	for _, p := range projects {
+		project := &asana.Project{ID: p.ID}
+		if err := project.Fetch(client, &asana.Options{Fields: customFieldSettingFields}); err != nil {
+			return nil, fmt.Errorf("failed to fetch custom fields of project %s: %w", p.ID, err)
+		}
+
+		for _, setting := range project.CustomFieldSettings {
+			if f := setting.CustomField; f != nil && !seen[f.ID] {
+				seen[f.ID] = true
+				fields = append(fields, f)
+			}
+		}
+	}
+
+	return fields, nil
+}
+
+// UserIDResolver returns a function resolving user names, emails, GIDs and
+// "me" to user GIDs, for use with CustomFieldValues. Users are fetched once,
+// when the first name is resolved.
+func UserIDResolver(client *asana.Client, cfg *config.Config) func(string) (string, error) {
+	var users []*asana.User
+	var fetched bool
+
+	return func(nameOrID string) (string, error) {
+		if !fetched {
+			var err error
+			if users, err = workspaceUsers(client, cfg); err != nil {
+				return "", err
+			}
+			fetched = true
+		}
+
+		user, err := findUser(client, cfg, users, nameOrID)
+		if err != nil {
+			return "", err
+		}
+		return user.ID, nil
+	}
+}
+
+// CustomFieldValues parses "Name=Value" assignments into the custom_fields
+// value of a task request. Fields are matched by name, case-insensitively, or
+// by GID, and an empty value clears the field.
+func CustomFieldValues(
+	fields []*asana.CustomField,
+	assignments []string,
+	resolveUser func(string) (string, error),
+) (map[string]any, error) {
+	values := make(map[string]any, len(assignments))
+
+	for _, a := range assignments {
+		name, value, ok := strings.Cut(a, "=")
+		name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid field %q: expected Name=Value", a)
+		}
+
+		field, err := findCustomField(fields, name)
+		if err != nil {
+			return nil, err
+		}
+
+		if value == "" {
+			values[field.ID] = nil
+			continue
+		}
+		if values[field.ID], err = customFieldValue(field, value, resolveUser); err != nil {
+			return nil, fmt.Errorf("invalid value for %s: %w", field.Name, err)
+		}
+	}
+
+	return values, nil
+}
+
+func findCustomField(fields []*asana.CustomField, name string) (*asana.CustomField, error) {
+	var found *asana.CustomField
+	for _, f := range fields {
+		if f.ID == name {
+			return f, nil
+		}
+		if strings.EqualFold(f.Name, name) {
+			if found != nil {
+				return nil, fmt.Errorf("custom field name %q is ambiguous: use the field ID", name)
+			}
+			found = f
+		}
+	}
+
+	if found == nil {
+		names := format.MapToStrings(fields, func(f *asana.CustomField) string {
+			return f.Name
+		})
+		if len(names) == 0 {
+			return nil, fmt.Errorf("custom field %q not found: the task's projects have no custom fields", name)
+		}
+		return nil, fmt.Errorf("custom field %q not found, available fields: %s", name, strings.Join(names, ", "))
+	}
+
+	return found, nil
+}
+
+func customFieldValue(
+	field *asana.CustomField,
+	value string,
+	resolveUser func(string) (string, error),
+) (any, error) {
+	switch field.ResourceSubtype {
+	case asana.FieldTypeText:
+		return value, nil
+
+	case asana.FieldTypeNumber:
+		return numberValue(field, value)
+
+	case asana.FieldTypeBoolean:
+		switch strings.ToLower(value) {
+		case "yes", "y", "on":
+			return true, nil
+		case "no", "n", "off":
+			return false, nil
+		}
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("expected true or false, got %q", value)
+		}
+		return b, nil
+
+	case asana.FieldTypeDate:
+		date, err := convert.ToDueDate(value, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		if date == nil {
+			// "None" clears the date like an empty value
+			return nil, nil
+		}
+		return map[string]any{"date": time.Time(*date).Format(time.DateOnly)}, nil
+
+	case asana.FieldTypeEnum:
+		option, err := enumOption(field, value)
+		if err != nil {
+			return nil, err
+		}
+		return option.ID, nil
+
+	case asana.FieldTypeMultiEnum:
+		var ids []string
+		for _, name := range splitList(value) {
+			option, err := enumOption(field, name)
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, option.ID)
+		}
+		return ids, nil
+
+	case asana.FieldTypePeople:
+		var ids []string
+		for _, name := range splitList(value) {
+			id, err := resolveUser(name)
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, id)
+		}
+		return ids, nil
+	}
+
+	return nil, fmt.Errorf("fields of type %q are not supported", field.ResourceSubtype)
+}
+
+// numberValue parses the value of a number field.
+func numberValue(field *asana.CustomField, value string) (float64, error) {
+	return ParseNumber(value, field.Format == asana.Percentage, field.Precision)
+}
+
+// ParseNumber parses a number and checks that it has no more decimal places
+// than precision allows, if set. Percentages are always given in percent,
+// with or without a % sign, and returned as the fraction the API stores, so
+// both 50 and 50% become 0.5.
+func ParseNumber(value string, percentage bool, precision *int) (float64, error) {
+	text := strings.TrimSpace(value)
+	if percentage {
+		text = strings.TrimSpace(strings.TrimSuffix(text, "%"))
+	}
+
+	n, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return 0, fmt.Errorf("expected a number, got %q", value)
+	}
+
+	if precision != nil {
+		if _, decimals, ok := strings.Cut(text, "."); ok && len(decimals) > *precision {
+			return 0, fmt.Errorf("%s allows at most %d decimal place(s)", value, *precision)
+		}
+	}
+
+	if percentage {
+		n /= 100
+	}
+	return n, nil
+}
+
+func enumOption(field *asana.CustomField, name string) (*asana.EnumValue, error) {
+	var names []string
+	for _, option := range field.EnumOptions {
+		if !option.Enabled {
+			continue
+		}
+		if option.ID == name || strings.EqualFold(option.Name, name) {
+			return option, nil
+		}
+		names = append(names, option.Name)
+	}
+	return nil, fmt.Errorf("unknown option %q, expected one of: %s", name, strings.Join(names, ", "))
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
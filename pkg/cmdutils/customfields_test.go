@@ -0,0 +1,138 @@
+package cmdutils
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/internal/config"
+)
+
+func customField(id, name string, typ asana.FieldType) *asana.CustomField {
+	f := &asana.CustomField{ID: id}
+	f.Name = name
+	f.ResourceSubtype = typ
+	return f
+}
+
+func enumOptions(f *asana.CustomField, names ...string) *asana.CustomField {
+	for i, name := range names {
+		option := &asana.EnumValue{ID: fmt.Sprintf("%s-%d", f.ID, i), Enabled: true}
+		option.Name = name
+		f.EnumOptions = append(f.EnumOptions, option)
+	}
+	return f
+}
+
+func TestCustomFieldValues(t *testing.T) {
+	precision := 1
+	estimate := customField("2", "Estimate", asana.FieldTypeNumber)
+	estimate.Precision = &precision
+	progress := customField("8", "Progress", asana.FieldTypeNumber)
+	progress.Format = asana.Percentage
+
+	fields := []*asana.CustomField{
+		enumOptions(customField("1", "Priority", asana.FieldTypeEnum), "Low", "High"),
+		estimate,
+		customField("3", "Reviewers", asana.FieldTypePeople),
+		customField("4", "Notes", asana.FieldTypeText),
+		customField("5", "Blocked", asana.FieldTypeBoolean),
+		customField("6", "Launch", asana.FieldTypeDate),
+		enumOptions(customField("7", "Platforms", asana.FieldTypeMultiEnum), "iOS", "Android", "Web"),
+		progress,
+	}
+
+	users := map[string]string{"alice": "100", "bob": "200"}
+	resolveUser := func(name string) (string, error) {
+		if id, ok := users[name]; ok {
+			return id, nil
+		}
+		return "", fmt.Errorf("user %q not found in workspace", name)
+	}
+
+	tests := []struct {
+		name        string
+		assignments []string
+		want        map[string]any
+		wantErr     string
+	}{
+		{
+			name:        "all types",
+			assignments: []string{"priority=high", "Estimate=3.5", "Reviewers=alice, bob", "Notes=a=b", "Blocked=yes", "Launch=2025-03-01", "Platforms=iOS,web", "Progress=25%"},
+			want: map[string]any{
+				"1": "1-1",
+				"2": 3.5,
+				"3": []string{"100", "200"},
+				"4": "a=b",
+				"5": true,
+				"6": map[string]any{"date": "2025-03-01"},
+				"7": []string{"7-0", "7-2"},
+				"8": 0.25,
+			},
+		},
+		{name: "by ID and clear", assignments: []string{"1="}, want: map[string]any{"1": nil}},
+		{name: "percent without sign", assignments: []string{"Progress=50"}, want: map[string]any{"8": 0.5}},
+		{name: "percent sign on number", assignments: []string{"Estimate=50%"}, wantErr: `invalid value for Estimate: expected a number, got "50%"`},
+		{name: "clear date with None", assignments: []string{"Launch=None"}, want: map[string]any{"6": nil}},
+		{name: "missing value", assignments: []string{"Priority"}, wantErr: `invalid field "Priority": expected Name=Value`},
+		{name: "unknown field", assignments: []string{"Owner=me"}, wantErr: `custom field "Owner" not found, available fields: Priority, Estimate, Reviewers, Notes, Blocked, Launch, Platforms, Progress`},
+		{name: "unknown option", assignments: []string{"Priority=Urgent"}, wantErr: `invalid value for Priority: unknown option "Urgent", expected one of: Low, High`},
+		{name: "precision", assignments: []string{"Estimate=3.25"}, wantErr: "invalid value for Estimate: 3.25 allows at most 1 decimal place(s)"},
+		{name: "not a number", assignments: []string{"Estimate=lots"}, wantErr: `invalid value for Estimate: expected a number, got "lots"`},
+		{name: "unknown user", assignments: []string{"Reviewers=carol"}, wantErr: `invalid value for Reviewers: user "carol" not found in workspace`},
+		{name: "not a boolean", assignments: []string{"Blocked=maybe"}, wantErr: `invalid value for Blocked: expected true or false, got "maybe"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CustomFieldValues(fields, tt.assignments, resolveUser)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCustomFieldValues_Ambiguous(t *testing.T) {
+	fields := []*asana.CustomField{
+		customField("1", "Priority", asana.FieldTypeText),
+		customField("2", "priority", asana.FieldTypeText),
+	}
+
+	_, err := CustomFieldValues(fields, []string{"Priority=High"}, nil)
+	require.EqualError(t, err, `custom field name "Priority" is ambiguous: use the field ID`)
+
+	got, err := CustomFieldValues(fields, []string{"2=High"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"2": "High"}, got)
+}
+
+func TestUserIDResolver_FetchesOnce(t *testing.T) {
+	defer gock.Off()
+
+	// gock mocks match a single request, so a second fetch would fail
+	gock.New("https://app.asana.com").
+		Get("/api/1.0/users").
+		Reply(200).
+		JSON(map[string]any{"data": []map[string]any{
+			{"gid": "100", "name": "Alice", "email": "alice@example.com"},
+			{"gid": "200", "name": "Bob"},
+		}})
+
+	cfg := &config.Config{Workspace: &asana.Workspace{ID: "W1"}}
+	resolve := UserIDResolver(asana.NewClient(http.DefaultClient), cfg)
+
+	for name, want := range map[string]string{"alice@example.com": "100", "bob": "200"} {
+		id, err := resolve(name)
+		require.NoError(t, err)
+		assert.Equal(t, want, id)
+	}
+	assert.True(t, gock.IsDone())
+}
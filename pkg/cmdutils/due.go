@@ -0,0 +1,21 @@
+package cmdutils
+
+import (
+	"time"
+
+	"github.com/timwehrle/asana/internal/api/asana"
+)
+
+// IsOverdue reports whether task is past its due date or time. A task due
+// today is not overdue until the day is over.
+func IsOverdue(task *asana.Task, now time.Time) bool {
+	if task.DueAt != nil {
+		return task.DueAt.Before(now)
+	}
+	if task.DueOn != nil {
+		due := time.Time(*task.DueOn)
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		return time.Date(due.Year(), due.Month(), due.Day(), 0, 0, 0, 0, time.UTC).Before(today)
+	}
+	return false
+}
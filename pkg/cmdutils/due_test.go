@@ -0,0 +1,34 @@
+package cmdutils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/internal/api/asana"
+)
+
+func TestIsOverdue(t *testing.T) {
+	now := time.Date(2025, 3, 10, 15, 0, 0, 0, time.Local)
+	date := func(s string) *asana.Date {
+		d, err := time.Parse(time.DateOnly, s)
+		require.NoError(t, err)
+		ad := asana.Date(d)
+		return &ad
+	}
+	at := func(t time.Time) *time.Time { return &t }
+
+	due := func(on *asana.Date, at *time.Time) *asana.Task {
+		t := &asana.Task{}
+		t.DueOn = on
+		t.DueAt = at
+		return t
+	}
+
+	assert.False(t, IsOverdue(due(nil, nil), now))
+	assert.True(t, IsOverdue(due(date("2025-03-09"), nil), now))
+	assert.False(t, IsOverdue(due(date("2025-03-10"), nil), now))
+	assert.True(t, IsOverdue(due(nil, at(now.Add(-time.Hour))), now))
+	assert.False(t, IsOverdue(due(nil, at(now.Add(time.Hour))), now))
+}
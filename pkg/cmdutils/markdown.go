@@ -0,0 +1,77 @@
+package cmdutils
+
+import (
+	"strings"
+
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/internal/config"
+	"github.com/timwehrle/asana/pkg/markdown"
+)
+
+// UseMarkdown reports whether rich text is authored as Markdown. An explicit
+// --markdown flag wins over the configured default.
+func UseMarkdown(flag *bool, cfg *config.Config) bool {
+	if flag != nil {
+		return *flag
+	}
+	return cfg != nil && cfg.Markdown
+}
+
+// MentionResolver resolves @mentions against the users of the workspace by
+// GID, full name, first name or email. Users are only fetched once a mention
+// is encountered.
+func MentionResolver(client *asana.Client, cfg *config.Config) markdown.MentionResolver {
+	var users []*asana.User
+	var fetched bool
+
+	return func(name string) (string, bool) {
+		if !fetched {
+			fetched = true
+			users, _ = workspaceUsers(client, cfg)
+		}
+
+		var firstNameMatches []*asana.User
+		for _, user := range users {
+			if user.ID == name || strings.EqualFold(user.Name, name) {
+				return user.ID, true
+			}
+			if user.Email != "" {
+				local, _, _ := strings.Cut(user.Email, "@")
+				if strings.EqualFold(user.Email, name) || strings.EqualFold(local, name) {
+					return user.ID, true
+				}
+			}
+			if first, _, _ := strings.Cut(user.Name, " "); strings.EqualFold(first, name) {
+				firstNameMatches = append(firstNameMatches, user)
+			}
+		}
+
+		// Ambiguous first names are left unresolved.
+		if len(firstNameMatches) == 1 {
+			return firstNameMatches[0].ID, true
+		}
+		return "", false
+	}
+}
+
+// MentionNames names mentioned users by GID with the users of the workspace,
+// the same users MentionResolver matches against. Users are only fetched once
+// a mention without a name is encountered.
+func MentionNames(client *asana.Client, cfg *config.Config) markdown.MentionNameResolver {
+	var users []*asana.User
+	var fetched bool
+
+	return func(gid string) (string, bool) {
+		if !fetched {
+			fetched = true
+			users, _ = workspaceUsers(client, cfg)
+		}
+
+		for _, user := range users {
+			if user.ID == gid {
+				return user.Name, true
+			}
+		}
+		return "", false
+	}
+}
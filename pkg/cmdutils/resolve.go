@@ -0,0 +1,272 @@
+package cmdutils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/internal/config"
+	"github.com/timwehrle/asana/internal/prompter"
+	"github.com/timwehrle/asana/pkg/format"
+)
+
+// ErrNotFound is wrapped by the errors of the resolvers when nothing in the
+// workspace matches the given name or ID.
+var ErrNotFound = errors.New("not found in workspace")
+
+// LinkedProject is the value of a bare --project flag. It selects the project
+// linked to the working directory through a .asana.yml file.
+const LinkedProject = "."
+
+// ResolveProject returns the project identified by nameOrID. When nameOrID is
+// empty or LinkedProject, the linked project is used, and the user is prompted
+// if the working directory is not linked.
+func ResolveProject(
+	client *asana.Client,
+	cfg *config.Config,
+	p prompter.Prompter,
+	nameOrID string,
+) (*asana.Project, error) {
+	if nameOrID == "" || nameOrID == LinkedProject {
+		if id := cfg.Link.ProjectID(); id != "" {
+			return &asana.Project{
+				ID:          id,
+				ProjectBase: asana.ProjectBase{Name: cfg.Link.Project.Name},
+			}, nil
+		}
+	}
+
+	ws := &asana.Workspace{ID: cfg.Workspace.ID}
+	projects, err := ws.AllProjects(client)
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch projects: %w", err)
+	}
+
+	if nameOrID != "" && nameOrID != LinkedProject {
+		for _, project := range projects {
+			if project.ID == nameOrID || strings.EqualFold(project.Name, nameOrID) {
+				return project, nil
+			}
+		}
+		return nil, fmt.Errorf("project %q not found in workspace", nameOrID)
+	}
+
+	if len(projects) == 0 {
+		return nil, fmt.Errorf("no projects found")
+	}
+
+	names := format.MapToStrings(projects, func(p *asana.Project) string {
+		return p.Name
+	})
+
+	selected, err := p.Select("Select project: ", names)
+	if err != nil {
+		return nil, fmt.Errorf("project selection failed: %w", err)
+	}
+	return projects[selected], nil
+}
+
+// ResolveTeam returns the team of the workspace identified by nameOrID, or
+// lets the user pick one when nameOrID is empty.
+func ResolveTeam(
+	client *asana.Client,
+	cfg *config.Config,
+	p prompter.Prompter,
+	nameOrID string,
+) (*asana.Team, error) {
+	ws := &asana.Workspace{ID: cfg.Workspace.ID}
+	teams, err := ws.AllTeams(client)
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch teams: %w", err)
+	}
+
+	if nameOrID != "" {
+		for _, team := range teams {
+			if team.ID == nameOrID || strings.EqualFold(team.Name, nameOrID) {
+				return team, nil
+			}
+		}
+		return nil, fmt.Errorf("team %q not found in workspace", nameOrID)
+	}
+
+	if len(teams) == 0 {
+		return nil, fmt.Errorf("no teams found")
+	}
+
+	names := format.MapToStrings(teams, func(t *asana.Team) string {
+		return t.Name
+	})
+
+	selected, err := p.Select("Select team: ", names)
+	if err != nil {
+		return nil, fmt.Errorf("team selection failed: %w", err)
+	}
+	return teams[selected], nil
+}
+
+// ResolveSection returns the section of project identified by nameOrID. When
+// nameOrID is empty, the linked section is used if it belongs to project, and
+// the user is prompted otherwise.
+func ResolveSection(
+	client *asana.Client,
+	cfg *config.Config,
+	p prompter.Prompter,
+	project *asana.Project,
+	nameOrID string,
+) (*asana.Section, error) {
+	if nameOrID == "" && cfg.Link.SectionID() != "" && cfg.Link.ProjectID() == project.ID {
+		return &asana.Section{
+			ID:          cfg.Link.Section.ID,
+			SectionBase: asana.SectionBase{Name: cfg.Link.Section.Name},
+		}, nil
+	}
+
+	sections, err := AllSections(client, project)
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch sections: %w", err)
+	}
+
+	if nameOrID != "" {
+		for _, section := range sections {
+			if section.ID == nameOrID || strings.EqualFold(section.Name, nameOrID) {
+				return section, nil
+			}
+		}
+		return nil, fmt.Errorf("section %q not found in project %q", nameOrID, project.Name)
+	}
+
+	if len(sections) == 0 {
+		return nil, fmt.Errorf("no sections found in project %q", project.Name)
+	}
+
+	names := format.MapToStrings(sections, func(s *asana.Section) string {
+		return s.Name
+	})
+
+	selected, err := p.Select("Select section: ", names)
+	if err != nil {
+		return nil, fmt.Errorf("section selection failed: %w", err)
+	}
+	return sections[selected], nil
+}
+
+// AllSections pages through all sections of a project.
+func AllSections(client *asana.Client, project *asana.Project) ([]*asana.Section, error) {
+	sections := make([]*asana.Section, 0, 20)
+	options := &asana.Options{}
+
+	for {
+		batch, nextPage, err := project.Sections(client, options)
+		if err != nil {
+			return nil, err
+		}
+
+		sections = append(sections, batch...)
+
+		if nextPage == nil || nextPage.Offset == "" {
+			break
+		}
+
+		options.Offset = nextPage.Offset
+	}
+
+	return sections, nil
+}
+
+// AllProjectTasks pages through all tasks of a project, requesting the given
+// task fields.
+func AllProjectTasks(client *asana.Client, project *asana.Project, fields []string) ([]*asana.Task, error) {
+	var tasks []*asana.Task
+	options := &asana.Options{Limit: 100, Fields: fields}
+
+	for {
+		batch, nextPage, err := project.Tasks(client, options)
+		if err != nil {
+			return nil, err
+		}
+
+		tasks = append(tasks, batch...)
+
+		if nextPage == nil || nextPage.Offset == "" {
+			return tasks, nil
+		}
+		options.Offset = nextPage.Offset
+	}
+}
+
+// ResolveUser returns the workspace user identified by nameOrID, which may be
+// "me", a GID, a name or an email address.
+func ResolveUser(client *asana.Client, cfg *config.Config, nameOrID string) (*asana.User, error) {
+	users, err := workspaceUsers(client, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return findUser(client, cfg, users, nameOrID)
+}
+
+func workspaceUsers(client *asana.Client, cfg *config.Config) ([]*asana.User, error) {
+	ws := &asana.Workspace{ID: cfg.Workspace.ID}
+	users, err := ws.AllUsers(client, &asana.Options{Fields: []string{"name", "email"}})
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch users: %w", err)
+	}
+	return users, nil
+}
+
+// findUser returns the user among users identified by nameOrID, as accepted
+// by ResolveUser.
+func findUser(client *asana.Client, cfg *config.Config, users []*asana.User, nameOrID string) (*asana.User, error) {
+	if strings.EqualFold(nameOrID, "me") {
+		id := cfg.UserID
+		if id == "" {
+			me, err := client.CurrentUser()
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch current user: %w", err)
+			}
+			id = me.ID
+		}
+		nameOrID = id
+	}
+
+	for _, user := range users {
+		if user.ID == nameOrID ||
+			strings.EqualFold(user.Name, nameOrID) ||
+			(user.Email != "" && strings.EqualFold(user.Email, nameOrID)) {
+			return user, nil
+		}
+	}
+
+	return nil, fmt.Errorf("user %q %w", nameOrID, ErrNotFound)
+}
+
+// ResolveTags returns the workspace tags identified by names, matching tag
+// names case-insensitively or by GID.
+func ResolveTags(client *asana.Client, cfg *config.Config, names []string) ([]*asana.Tag, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	ws := &asana.Workspace{ID: cfg.Workspace.ID}
+	tags, err := ws.AllTags(client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch tags: %w", err)
+	}
+
+	result := make([]*asana.Tag, 0, len(names))
+	for _, name := range names {
+		var found *asana.Tag
+		for _, tag := range tags {
+			if tag.ID == name || strings.EqualFold(tag.Name, name) {
+				found = tag
+				break
+			}
+		}
+		if found == nil {
+			return nil, fmt.Errorf("tag %q not found in workspace", name)
+		}
+		result = append(result, found)
+	}
+
+	return result, nil
+}
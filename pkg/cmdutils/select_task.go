@@ -1,23 +1,37 @@
 package cmdutils
 
 import (
+	"errors"
 	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
 
 	"github.com/timwehrle/asana/internal/api/asana"
 	"github.com/timwehrle/asana/internal/config"
+	"github.com/timwehrle/asana/internal/git"
 	"github.com/timwehrle/asana/internal/prompter"
 	"github.com/timwehrle/asana/pkg/format"
 	"github.com/timwehrle/asana/pkg/iostreams"
 )
 
 type BaseOptions struct {
-	IO       *iostreams.IOStreams
-	Prompter prompter.Prompter
-	Config   func() (*config.Config, error)
-	Client   func() (*asana.Client, error)
+	IO        *iostreams.IOStreams
+	Prompter  prompter.Prompter
+	Config    func() (*config.Config, error)
+	Client    func() (*asana.Client, error)
+	GitClient *git.Client
 }
 
-func SelectTask(opts *BaseOptions, c *asana.Client) (*asana.Task, error) {
+var gidRe = regexp.MustCompile(`^[0-9]+$`)
+
+// ErrNoTask is returned when the user has no tasks to select from. The user
+// has already been told, so the command exits without an error message.
+var ErrNoTask = errors.New("no task selected")
+
+// SelectTask lets the user pick one of their incomplete tasks and fetches its
+// details. It returns nil if the user has no tasks.
+func SelectTask(opts *BaseOptions, c *asana.Client, message string) (*asana.Task, error) {
 	cfg, err := opts.Config()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get config: %w", err)
@@ -40,7 +54,7 @@ func SelectTask(opts *BaseOptions, c *asana.Client) (*asana.Task, error) {
 	}
 
 	taskNames := format.Tasks(tasks)
-	index, err := opts.Prompter.Select("Select a task to view tracked time:", taskNames)
+	index, err := opts.Prompter.Select(message, taskNames)
 	if err != nil {
 		return nil, fmt.Errorf("failed to select task: %w", err)
 	}
@@ -52,3 +66,101 @@ func SelectTask(opts *BaseOptions, c *asana.Client) (*asana.Task, error) {
 
 	return selectedTask, nil
 }
+
+// ResolveTask returns the task referenced by ref, a GID or task URL. Without
+// a reference, the task encoded in the current git branch name is used, and as
+// a last resort the user picks one of their tasks with the given message.
+// The returned task is fully fetched.
+func ResolveTask(opts *BaseOptions, c *asana.Client, ref, message string) (*asana.Task, error) {
+	var id string
+	if ref != "" {
+		parsed, err := ParseTaskRef(ref)
+		if err != nil {
+			return nil, err
+		}
+		id = parsed
+	} else {
+		id = TaskIDFromBranch(opts.GitClient)
+	}
+
+	if id == "" {
+		task, err := SelectTask(opts, c, message)
+		if err != nil {
+			return nil, err
+		}
+		if task == nil {
+			return nil, ErrNoTask
+		}
+		return task, nil
+	}
+
+	task := &asana.Task{ID: id}
+	if err := task.Fetch(c); err != nil {
+		if asana.IsNotFoundError(err) {
+			return nil, fmt.Errorf("task %s not found", id)
+		}
+		return nil, fmt.Errorf("failed to fetch task details: %w", err)
+	}
+
+	return task, nil
+}
+
+// ResolveTasks fetches the tasks referenced by IDs or URLs.
+func ResolveTasks(client *asana.Client, refs []string) ([]*asana.Task, error) {
+	tasks := make([]*asana.Task, 0, len(refs))
+
+	for _, ref := range refs {
+		id, err := ParseTaskRef(ref)
+		if err != nil {
+			return nil, err
+		}
+
+		task := &asana.Task{ID: id}
+		if err := task.Fetch(client, &asana.Options{Fields: []string{"name"}}); err != nil {
+			if asana.IsNotFoundError(err) {
+				return nil, fmt.Errorf("task %s not found", id)
+			}
+			return nil, fmt.Errorf("failed to fetch task details: %w", err)
+		}
+		tasks = append(tasks, task)
+	}
+
+	return tasks, nil
+}
+
+// TaskIDFromBranch returns the task GID encoded in the name of the checked out
+// git branch, or an empty string.
+func TaskIDFromBranch(gc *git.Client) string {
+	if gc == nil {
+		gc = &git.Client{}
+	}
+
+	branch, err := gc.CurrentBranch()
+	if err != nil {
+		return ""
+	}
+
+	id, _ := git.TaskIDFromBranch(branch)
+	return id
+}
+
+// ParseTaskRef extracts a task GID from a GID or an Asana task URL.
+func ParseTaskRef(ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if gidRe.MatchString(ref) {
+		return ref, nil
+	}
+
+	u, err := url.Parse(ref)
+	if err == nil && u.Host != "" {
+		segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+		for i := len(segments) - 1; i >= 0; i-- {
+			// Skip the zero placeholders used in legacy URLs
+			if gidRe.MatchString(segments[i]) && strings.Trim(segments[i], "0") != "" {
+				return segments[i], nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("invalid task reference %q: expected a task ID or URL", ref)
+}
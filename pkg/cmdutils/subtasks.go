@@ -0,0 +1,108 @@
+package cmdutils
+
+import (
+	"fmt"
+
+	"github.com/timwehrle/asana/internal/api/asana"
+	"github.com/timwehrle/asana/pkg/format"
+	"github.com/timwehrle/asana/pkg/iostreams"
+)
+
+// SubtaskFields are the task fields needed to print a subtask tree.
+var SubtaskFields = []string{"name", "completed", "due_on", "assignee.name", "num_subtasks"}
+
+// TaskNode is a task together with its subtasks.
+type TaskNode struct {
+	*asana.Task
+
+	Subtasks []*TaskNode `json:"subtasks,omitempty"`
+}
+
+// SubtaskTree fetches the subtasks of task recursively. A depth of zero or
+// less fetches all levels.
+func SubtaskTree(client *asana.Client, task *asana.Task, depth int) ([]*TaskNode, error) {
+	subtasks, err := AllSubtasks(client, task, SubtaskFields...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch subtasks of %q: %w", task.Name, err)
+	}
+
+	nodes := make([]*TaskNode, 0, len(subtasks))
+	for _, t := range subtasks {
+		node := &TaskNode{Task: t}
+		if t.NumSubtasks > 0 && depth != 1 {
+			if node.Subtasks, err = SubtaskTree(client, t, depth-1); err != nil {
+				return nil, err
+			}
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+// AllSubtasks pages through the direct subtasks of a task.
+func AllSubtasks(client *asana.Client, task *asana.Task, fields ...string) ([]*asana.Task, error) {
+	var subtasks []*asana.Task
+	options := &asana.Options{Limit: 100, Fields: fields}
+
+	for {
+		batch, nextPage, err := task.Subtasks(client, options)
+		if err != nil {
+			return nil, err
+		}
+		subtasks = append(subtasks, batch...)
+
+		if nextPage == nil || nextPage.Offset == "" {
+			return subtasks, nil
+		}
+		options.Offset = nextPage.Offset
+	}
+}
+
+// CountTasks returns the number of tasks in a tree and how many of them are
+// completed.
+func CountTasks(nodes []*TaskNode) (total, completed int) {
+	for _, n := range nodes {
+		total++
+		if n.Completed != nil && *n.Completed {
+			completed++
+		}
+		t, c := CountTasks(n.Subtasks)
+		total += t
+		completed += c
+	}
+	return total, completed
+}
+
+// PrintTaskTree prints a tree of tasks with completion checkboxes, due dates
+// and assignees. Every line starts with prefix.
+func PrintTaskTree(io *iostreams.IOStreams, nodes []*TaskNode, prefix string) {
+	cs := io.ColorScheme()
+
+	for i, n := range nodes {
+		branch, indent := "├── ", "│   "
+		if i == len(nodes)-1 {
+			branch, indent = "└── ", "    "
+		}
+
+		box := "[ ]"
+		name := n.Name
+		if n.Completed != nil && *n.Completed {
+			box = cs.Success("[x]")
+			name = cs.Gray(name)
+		}
+
+		line := fmt.Sprintf("%s%s%s %s", prefix, branch, box, name)
+		if n.DueOn != nil {
+			line += cs.Gray(" · " + format.Date(n.DueOn))
+		}
+		if n.Assignee != nil && n.Assignee.Name != "" {
+			line += cs.Gray(" @" + n.Assignee.Name)
+		}
+		if len(n.Subtasks) == 0 && n.NumSubtasks > 0 {
+			line += cs.Gray(fmt.Sprintf(" (+%d)", n.NumSubtasks))
+		}
+		io.Println(line)
+
+		PrintTaskTree(io, n.Subtasks, prefix+indent)
+	}
+}
@@ -0,0 +1,34 @@
+package cmdutils
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/internal/api/asana"
+)
+
+func TestAllSubtasks_Pages(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://app.asana.com").
+		Get("/api/1.0/tasks/1/subtasks").
+		MatchParam("offset", "next").
+		Reply(200).
+		JSON(map[string]any{"data": []map[string]any{{"gid": "3", "name": "Third"}}})
+	gock.New("https://app.asana.com").
+		Get("/api/1.0/tasks/1/subtasks").
+		Reply(200).
+		JSON(map[string]any{
+			"data":      []map[string]any{{"gid": "2", "name": "Second"}},
+			"next_page": map[string]any{"offset": "next"},
+		})
+
+	subtasks, err := AllSubtasks(asana.NewClient(http.DefaultClient), &asana.Task{ID: "1"}, "name")
+	require.NoError(t, err)
+	require.Len(t, subtasks, 2)
+	assert.Equal(t, "2", subtasks[0].ID)
+	assert.Equal(t, "3", subtasks[1].ID)
+}
@@ -1,6 +1,9 @@
 package convert
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/timwehrle/asana/internal/api/asana"
@@ -31,3 +34,48 @@ func ToDate(dateStr string, layout string) (*asana.Date, error) {
 	asanaDate := asana.Date(zeroedTime)
 	return &asanaDate, nil
 }
+
+// ToSince converts a --since value into the point in time it refers to. It
+// accepts a date (YYYY-MM-DD) or a duration before now such as 90m, 12h, 7d
+// or 2w.
+func ToSince(value string, now time.Time) (time.Time, error) {
+	if t, err := time.ParseInLocation(time.DateOnly, value, now.Location()); err == nil {
+		return t, nil
+	}
+
+	if n := len(value); n > 1 {
+		if days, err := strconv.Atoi(value[:n-1]); err == nil && days >= 0 {
+			switch value[n-1] {
+			case 'd':
+				return now.AddDate(0, 0, -days), nil
+			case 'w':
+				return now.AddDate(0, 0, -7*days), nil
+			}
+		}
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return time.Time{}, fmt.Errorf("invalid time %q: use a date (YYYY-MM-DD) or a duration like 12h, 7d or 2w", value)
+	}
+	return now.Add(-d), nil
+}
+
+// ToDueDate converts a due date given as YYYY-MM-DD, "today" or "tomorrow".
+// An empty input means no due date.
+func ToDueDate(input string, now time.Time) (*asana.Date, error) {
+	switch strings.ToLower(strings.TrimSpace(input)) {
+	case "":
+		return nil, nil
+	case "today":
+		return ToDate(now.Format(time.DateOnly), time.DateOnly)
+	case "tomorrow":
+		return ToDate(now.AddDate(0, 0, 1).Format(time.DateOnly), time.DateOnly)
+	}
+
+	due, err := ToDate(input, time.DateOnly)
+	if err != nil {
+		return nil, fmt.Errorf("invalid due date %q: %w", input, err)
+	}
+	return due, nil
+}
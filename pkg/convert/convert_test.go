@@ -109,3 +109,40 @@ func TestToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestToSince(t *testing.T) {
+	now := time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{value: "2025-03-01", want: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{value: "90m", want: now.Add(-90 * time.Minute)},
+		{value: "12h", want: now.Add(-12 * time.Hour)},
+		{value: "7d", want: time.Date(2025, 3, 8, 12, 0, 0, 0, time.UTC)},
+		{value: "2w", want: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)},
+		{value: "yesterday", wantErr: true},
+		{value: "-3d", wantErr: true},
+		{value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := convert.ToSince(tt.value, now)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ToSince(%q) expected an error", tt.value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ToSince(%q) unexpected error: %v", tt.value, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ToSince(%q) = %v; want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
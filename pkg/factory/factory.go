@@ -4,6 +4,7 @@ import (
 	"github.com/timwehrle/asana/internal/api/asana"
 	"github.com/timwehrle/asana/internal/auth"
 	"github.com/timwehrle/asana/internal/config"
+	"github.com/timwehrle/asana/internal/git"
 	"github.com/timwehrle/asana/internal/prompter"
 	"github.com/timwehrle/asana/pkg/iostreams"
 )
@@ -14,6 +15,7 @@ type Factory struct {
 
 	Prompter  prompter.Prompter
 	IOStreams *iostreams.IOStreams
+	GitClient *git.Client
 }
 
 func New() *Factory {
@@ -23,6 +25,7 @@ func New() *Factory {
 	f.Prompter = newPrompter()
 	f.Client = newClientFunc()
 	f.Config = newConfigFunc()
+	f.GitClient = &git.Client{}
 
 	return f
 }
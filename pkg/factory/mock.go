@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"github.com/timwehrle/asana/internal/api/asana"
 	"github.com/timwehrle/asana/internal/config"
+	"github.com/timwehrle/asana/internal/git"
 	"github.com/timwehrle/asana/pkg/iostreams"
 )
 
@@ -36,5 +37,6 @@ func NewTestFactory() (Factory, *bytes.Buffer, *bytes.Buffer) {
 		Config:    fakeConfig,
 		Client:    fakeClient,
 		Prompter:  nil,
+		GitClient: &git.Client{},
 	}, outBuf, errBuf
 }
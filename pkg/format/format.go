@@ -4,11 +4,13 @@ import (
 	"bytes"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/timwehrle/asana/internal/api/asana"
 	"github.com/timwehrle/asana/pkg/iostreams"
+	"github.com/timwehrle/asana/pkg/markdown"
 )
 
 func MapToStrings[T any](items []*T, fn func(*T) string) []string {
@@ -57,6 +59,51 @@ func Notes(notes string) string {
 	return cs.Bold("Description:") + "\n" + notes + "\n"
 }
 
+// RichText renders Asana rich text such as a task's html_notes or a story's
+// html_text. Formatting, hyperlinks and wrapping are only applied when stdout
+// is a terminal. The plain text version is returned if doc is empty or cannot
+// be parsed.
+func RichText(doc, plain string, io *iostreams.IOStreams) string {
+	return IndentedRichText(doc, plain, io, "")
+}
+
+// IndentedRichText is like RichText but prefixes every line with indent,
+// wrapping to the remaining width.
+func IndentedRichText(doc, plain string, io *iostreams.IOStreams, indent string) string {
+	if strings.TrimSpace(doc) == "" {
+		return Indent(strings.TrimSpace(plain), indent)
+	}
+
+	var opts markdown.RenderOptions
+	if io.IsStdoutTTY {
+		cs := io.ColorScheme()
+		opts = markdown.RenderOptions{
+			Width:         io.TerminalWidth() - len(indent),
+			Heading:       cs.Underline,
+			Bold:          cs.Bold,
+			Italic:        cs.Italic,
+			Underline:     cs.Underline,
+			Strikethrough: cs.Strike,
+			Dim:           cs.Dim,
+			Code: func(s string) string {
+				return io.Color(s, "yellow")
+			},
+			Mention: func(s string) string {
+				return io.Color(s, "cyan+b")
+			},
+		}
+		if io.HyperlinksEnabled() {
+			opts.Hyperlink = iostreams.Hyperlink
+		}
+	}
+
+	out, err := markdown.Render(doc, opts)
+	if err != nil {
+		out = strings.TrimSpace(plain)
+	}
+	return Indent(out, indent)
+}
+
 func Date(date *asana.Date) string {
 	if date == nil {
 		return "None"
@@ -114,6 +161,32 @@ func HumanDate(t time.Time) string {
 	}
 }
 
+// RelativeTime describes how long ago t was, falling back to the date for
+// times more than a month ago.
+func RelativeTime(t time.Time) string {
+	d := time.Since(t)
+
+	plural := func(n int, unit string) string {
+		if n == 1 {
+			return "1 " + unit + " ago"
+		}
+		return fmt.Sprintf("%d %ss ago", n, unit)
+	}
+
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		return plural(int(d.Minutes()), "minute")
+	case d < 24*time.Hour:
+		return plural(int(d.Hours()), "hour")
+	case d < 30*24*time.Hour:
+		return plural(int(d.Hours()/24), "day")
+	default:
+		return t.Local().Format("Jan 02, 2006")
+	}
+}
+
 func Indent(s, prefix string) string {
 	if len(strings.TrimSpace(s)) == 0 {
 		return s
@@ -171,3 +244,115 @@ func Duration(minutes int) string {
 	}
 	return strings.Join(parts, " ")
 }
+
+// CustomFieldValue formats the value of a custom field on a task according to
+// its type. Unset values are shown as "None".
+func CustomFieldValue(f *asana.CustomFieldValue) string {
+	var value string
+
+	switch f.ResourceSubtype {
+	case asana.FieldTypeText:
+		if f.TextValue != nil {
+			value = *f.TextValue
+		}
+	case asana.FieldTypeNumber:
+		if f.NumberValue != nil {
+			value = Number(&f.CustomField, *f.NumberValue)
+		}
+	case asana.FieldTypeEnum:
+		if f.EnumValue != nil {
+			value = f.EnumValue.Name
+		}
+	case asana.FieldTypeMultiEnum:
+		value = strings.Join(MapToStrings(f.MultiEnumValues, func(e *asana.EnumValue) string {
+			return e.Name
+		}), ", ")
+	case asana.FieldTypeDate:
+		if f.DateValue != nil && f.DateValue.DateTime != nil {
+			value = f.DateValue.DateTime.Local().Format("Jan 02, 2006 15:04")
+		} else if f.DateValue != nil && f.DateValue.Date != nil {
+			value = Date(f.DateValue.Date)
+		}
+	case asana.FieldTypeBoolean:
+		if f.BooleanValue != nil {
+			value = "No"
+			if *f.BooleanValue {
+				value = "Yes"
+			}
+		}
+	case asana.FieldTypePeople:
+		value = strings.Join(MapToStrings(f.PeopleValue, func(u *asana.User) string {
+			return u.Name
+		}), ", ")
+	default:
+		if f.DisplayValue != nil {
+			value = *f.DisplayValue
+		}
+	}
+
+	if value == "" {
+		return "None"
+	}
+	return value
+}
+
+// Number formats v as a value of the number field f, using the field's
+// format and precision. Percentages are shown in percent.
+func Number(f *asana.CustomField, v float64) string {
+	precision := 0
+	if f.Precision != nil {
+		precision = *f.Precision
+	}
+
+	switch f.Format {
+	case asana.Percentage:
+		return strconv.FormatFloat(v*100, 'f', precision, 64) + "%"
+	case asana.Currency:
+		return strings.TrimSpace(f.CurrencyCode + " " + strconv.FormatFloat(v, 'f', precision, 64))
+	case asana.Custom:
+		s := strconv.FormatFloat(v, 'f', precision, 64)
+		if f.CustomLabel == "" {
+			return s
+		}
+		if f.CustomLabelPosition == asana.Prefix {
+			return f.CustomLabel + s
+		}
+		return s + " " + f.CustomLabel
+	}
+
+	return strconv.FormatFloat(v, 'f', precision, 64)
+}
+
+// ProgressBar draws a bar of width characters, filled in proportion to
+// completed out of total.
+func ProgressBar(completed, total, width int) string {
+	filled := 0
+	if total > 0 {
+		filled = min(completed*width/total, width)
+	}
+	return strings.Repeat("█", filled) + strings.Repeat("░", width-filled)
+}
+
+// Percent returns part as a whole percentage of total, or 0 if total is 0.
+func Percent(part, total int) int {
+	if total == 0 {
+		return 0
+	}
+	return part * 100 / total
+}
+
+// Bytes formats a file size in bytes using binary units.
+func Bytes(n int) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+
+	div, exp := unit, 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -296,3 +296,102 @@ func TestDedent(t *testing.T) {
 		})
 	}
 }
+
+func TestCustomFieldValue(t *testing.T) {
+	ptr := func(v float64) *float64 { return &v }
+	str := func(s string) *string { return &s }
+	yes := true
+	two := 2
+
+	field := func(subtype asana.FieldType) asana.CustomFieldValue {
+		var f asana.CustomFieldValue
+		f.ResourceSubtype = subtype
+		return f
+	}
+
+	text := field(asana.FieldTypeText)
+	text.TextValue = str("hello")
+
+	percent := field(asana.FieldTypeNumber)
+	percent.NumberValue = ptr(0.25)
+	percent.Format = asana.Percentage
+
+	currency := field(asana.FieldTypeNumber)
+	currency.NumberValue = ptr(12.5)
+	currency.Precision = &two
+	currency.Format = asana.Currency
+	currency.CurrencyCode = "EUR"
+
+	custom := field(asana.FieldTypeNumber)
+	custom.NumberValue = ptr(3)
+	custom.Format = asana.Custom
+	custom.CustomLabel = "pts"
+	custom.CustomLabelPosition = asana.Suffix
+
+	enum := field(asana.FieldTypeEnum)
+	enum.EnumValue = &asana.EnumValue{EnumValueBase: asana.EnumValueBase{Name: "High"}}
+
+	multi := field(asana.FieldTypeMultiEnum)
+	multi.MultiEnumValues = []*asana.EnumValue{
+		{EnumValueBase: asana.EnumValueBase{Name: "A"}},
+		{EnumValueBase: asana.EnumValueBase{Name: "B"}},
+	}
+
+	boolean := field(asana.FieldTypeBoolean)
+	boolean.BooleanValue = &yes
+
+	people := field(asana.FieldTypePeople)
+	people.PeopleValue = []*asana.User{{Name: "Jane"}, {Name: "John"}}
+
+	unknown := field("formula")
+	unknown.DisplayValue = str("42")
+
+	tests := []struct {
+		name  string
+		field asana.CustomFieldValue
+		want  string
+	}{
+		{"text", text, "hello"},
+		{"percentage", percent, "25%"},
+		{"currency", currency, "EUR 12.50"},
+		{"custom label", custom, "3 pts"},
+		{"enum", enum, "High"},
+		{"multi enum", multi, "A, B"},
+		{"boolean", boolean, "Yes"},
+		{"people", people, "Jane, John"},
+		{"display value fallback", unknown, "42"},
+		{"unset", field(asana.FieldTypeText), "None"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, format.CustomFieldValue(&tt.field))
+		})
+	}
+}
+
+func TestBytes(t *testing.T) {
+	assert.Equal(t, "512 B", format.Bytes(512))
+	assert.Equal(t, "1.5 KiB", format.Bytes(1536))
+	assert.Equal(t, "3.0 MiB", format.Bytes(3*1024*1024))
+}
+
+func TestProgressBar(t *testing.T) {
+	assert.Equal(t, "░░░░", format.ProgressBar(0, 0, 4))
+	assert.Equal(t, "██░░", format.ProgressBar(5, 10, 4))
+	assert.Equal(t, "████", format.ProgressBar(12, 10, 4))
+	assert.Equal(t, 33, format.Percent(1, 3))
+	assert.Equal(t, 0, format.Percent(1, 0))
+}
+
+func TestRelativeTime(t *testing.T) {
+	now := time.Now()
+
+	assert.Equal(t, "just now", format.RelativeTime(now.Add(-10*time.Second)))
+	assert.Equal(t, "1 minute ago", format.RelativeTime(now.Add(-90*time.Second)))
+	assert.Equal(t, "3 hours ago", format.RelativeTime(now.Add(-3*time.Hour)))
+	assert.Equal(t, "2 days ago", format.RelativeTime(now.Add(-49*time.Hour)))
+
+	old := now.AddDate(0, -3, 0)
+	assert.Equal(t, old.Format("Jan 02, 2006"), format.RelativeTime(old))
+}
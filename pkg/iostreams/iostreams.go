@@ -5,12 +5,18 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/mattn/go-colorable"
 	"github.com/mattn/go-isatty"
 	"github.com/mgutz/ansi"
+	"golang.org/x/term"
 )
 
+// DefaultWidth is used for wrapping when the terminal size is unknown.
+const DefaultWidth = 80
+
 // ColorScheme holds the configured colors for various types of output
 type ColorScheme struct {
 	// Commands and UI Elements
@@ -26,6 +32,7 @@ type ColorScheme struct {
 	Dim         func(string) string
 	Italic      func(string) string
 	Underline   func(string) string
+	Strike      func(string) string
 	SuccessIcon string
 	WarningIcon string
 	ErrorIcon   string
@@ -100,6 +107,12 @@ func Test() (*IOStreams, *bytes.Buffer, *bytes.Buffer, *bytes.Buffer) {
 	return testIO, in, out, errOut
 }
 
+// StripGray removes the escape codes of ColorScheme.Gray, which colors its
+// text even when colors are disabled, so tests can compare plain output.
+func StripGray(s string) string {
+	return strings.NewReplacer("\x1b[0;90m", "", "\x1b[90m", "", "\x1b[0m", "").Replace(s)
+}
+
 // ColorScheme returns the color scheme for the streams
 func (io *IOStreams) ColorScheme() *ColorScheme {
 	return io.colorScheme
@@ -113,6 +126,56 @@ func isTerminal(f any) bool {
 	return false
 }
 
+// TerminalWidth returns the width of the terminal attached to stdout. The
+// COLUMNS environment variable takes precedence, and DefaultWidth is used when
+// the width cannot be determined.
+func (io *IOStreams) TerminalWidth() int {
+	if n, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && n > 0 {
+		return n
+	}
+
+	if f, ok := io.Out.(*os.File); ok {
+		if w, _, err := term.GetSize(int(f.Fd())); err == nil && w > 0 {
+			return w
+		}
+	}
+	if io.IsStdoutTTY {
+		if w, _, err := term.GetSize(int(os.Stdout.Fd())); err == nil && w > 0 {
+			return w
+		}
+	}
+
+	return DefaultWidth
+}
+
+// HyperlinksEnabled reports whether links can be printed as OSC 8 terminal
+// hyperlinks. Set FORCE_HYPERLINK to 1 or 0 to override the detection.
+func (io *IOStreams) HyperlinksEnabled() bool {
+	if v := os.Getenv("FORCE_HYPERLINK"); v != "" {
+		return v != "0"
+	}
+	if !io.IsStdoutTTY || !io.ColorEnabled {
+		return false
+	}
+
+	switch os.Getenv("TERM_PROGRAM") {
+	case "iTerm.app", "WezTerm", "vscode", "ghostty", "Hyper":
+		return true
+	}
+	if n, err := strconv.Atoi(os.Getenv("VTE_VERSION")); err == nil && n >= 5000 {
+		return true
+	}
+
+	return os.Getenv("WT_SESSION") != "" ||
+		os.Getenv("KITTY_WINDOW_ID") != "" ||
+		os.Getenv("KONSOLE_VERSION") != ""
+}
+
+// Hyperlink wraps text in an OSC 8 escape sequence linking to url.
+func Hyperlink(text, url string) string {
+	return "\x1b]8;;" + url + "\x1b\\" + text + "\x1b]8;;\x1b\\"
+}
+
 // ForceColor forces the use of colors in output
 func (io *IOStreams) ForceColor() {
 	io.ColorEnabled = true
@@ -201,6 +264,13 @@ func (io *IOStreams) initColorScheme() {
 			return ansi.Color(s, "default+u")
 		},
 
+		Strike: func(s string) string {
+			if !useColors {
+				return s
+			}
+			return ansi.Color(s, "default+s")
+		},
+
 		SuccessIcon: func() string {
 			if !useColors {
 				return "✓"
@@ -0,0 +1,308 @@
+package markdown
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// node is an element or, when tag is empty, a run of text.
+type node struct {
+	tag      string
+	attrs    map[string]string
+	text     string
+	children []*node
+}
+
+func (n *node) textContent() string {
+	if n.tag == "" {
+		return n.text
+	}
+	var b strings.Builder
+	for _, c := range n.children {
+		b.WriteString(c.textContent())
+	}
+	return b.String()
+}
+
+// htmlReader converts parsed rich text to Markdown.
+type htmlReader struct {
+	names MentionNameResolver
+}
+
+var blankLinesRe = regexp.MustCompile(`\n{3,}`)
+
+// FromHTML converts Asana rich text to Markdown. Elements without a Markdown
+// equivalent, such as underline, are reduced to their text. Mentions without
+// link text are named with names, which may be nil.
+func FromHTML(doc string, names MentionNameResolver) (string, error) {
+	if strings.TrimSpace(doc) == "" {
+		return "", nil
+	}
+
+	root, err := parse(doc)
+	if err != nil {
+		return "", err
+	}
+
+	r := &htmlReader{names: names}
+	md := blankLinesRe.ReplaceAllString(r.renderBlocks(root.children), "\n\n")
+	return strings.TrimSpace(md), nil
+}
+
+func parse(doc string) (*node, error) {
+	d := xml.NewDecoder(strings.NewReader(doc))
+	d.Strict = false
+	d.AutoClose = xml.HTMLAutoClose
+	d.Entity = xml.HTMLEntity
+
+	root := &node{tag: "body"}
+	stack := []*node{root}
+
+	for {
+		tok, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse rich text: %w", err)
+		}
+
+		top := stack[len(stack)-1]
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if t.Name.Local == "body" && len(stack) == 1 {
+				continue
+			}
+			n := &node{tag: t.Name.Local, attrs: map[string]string{}}
+			for _, a := range t.Attr {
+				n.attrs[a.Name.Local] = a.Value
+			}
+			top.children = append(top.children, n)
+			stack = append(stack, n)
+
+		case xml.EndElement:
+			if len(stack) > 1 && stack[len(stack)-1].tag == t.Name.Local {
+				stack = stack[:len(stack)-1]
+			}
+
+		case xml.CharData:
+			top.children = append(top.children, &node{text: string(t)})
+		}
+	}
+
+	return root, nil
+}
+
+func isBlockTag(tag string) bool {
+	switch tag {
+	case "h1", "h2", "ul", "ol", "pre", "blockquote", "hr":
+		return true
+	}
+	return false
+}
+
+// renderBlocks renders a mix of inline content and block elements. Blocks are
+// surrounded by blank lines, which are collapsed afterwards.
+func (r *htmlReader) renderBlocks(nodes []*node) string {
+	var b strings.Builder
+	var run []*node
+
+	flush := func() {
+		if len(run) > 0 {
+			b.WriteString(r.renderInline(run))
+			run = nil
+		}
+	}
+
+	for _, n := range nodes {
+		if !isBlockTag(n.tag) {
+			run = append(run, n)
+			continue
+		}
+		flush()
+
+		b.WriteString("\n\n")
+		switch n.tag {
+		case "h1":
+			b.WriteString("# " + strings.TrimSpace(r.renderInline(n.children)))
+		case "h2":
+			b.WriteString("## " + strings.TrimSpace(r.renderInline(n.children)))
+		case "hr":
+			b.WriteString("---")
+		case "pre":
+			b.WriteString("```\n" + strings.Trim(n.textContent(), "\n") + "\n```")
+		case "ul", "ol":
+			b.WriteString(r.renderList(n, ""))
+		case "blockquote":
+			inner := strings.TrimSpace(blankLinesRe.ReplaceAllString(r.renderBlocks(n.children), "\n\n"))
+			b.WriteString(prefixLines(inner, "> ", ">"))
+		}
+		b.WriteString("\n\n")
+	}
+	flush()
+
+	return b.String()
+}
+
+func (r *htmlReader) renderList(list *node, indent string) string {
+	var lines []string
+	number := 1
+
+	for _, item := range list.children {
+		if item.tag != "li" {
+			continue
+		}
+
+		marker := "- "
+		if list.tag == "ol" {
+			marker = strconv.Itoa(number) + ". "
+			number++
+		}
+		pad := indent + strings.Repeat(" ", len(marker))
+
+		var inline []*node
+		var nested []string
+		for _, c := range item.children {
+			if c.tag == "ul" || c.tag == "ol" {
+				nested = append(nested, r.renderList(c, pad))
+				continue
+			}
+			inline = append(inline, c)
+		}
+
+		text := strings.TrimSpace(r.renderInline(inline))
+		lines = append(lines, indent+marker+prefixLines(text, pad, "")[len(pad):])
+		lines = append(lines, nested...)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// prefixLines prefixes every line of s, using blank for empty lines.
+func prefixLines(s, prefix, blank string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if line == "" {
+			lines[i] = blank
+		} else {
+			lines[i] = prefix + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (r *htmlReader) renderInline(nodes []*node) string {
+	var b strings.Builder
+
+	for _, n := range nodes {
+		switch n.tag {
+		case "":
+			b.WriteString(escape(n.text, b.Len() == 0 || strings.HasSuffix(b.String(), "\n")))
+		case "strong", "b":
+			b.WriteString(wrap(r.renderInline(n.children), "**"))
+		case "em", "i":
+			b.WriteString(wrap(r.renderInline(n.children), "*"))
+		case "s", "strike", "del":
+			b.WriteString(wrap(r.renderInline(n.children), "~~"))
+		case "code":
+			b.WriteString("`" + n.textContent() + "`")
+		case "br":
+			b.WriteString("\n")
+		case "a":
+			b.WriteString(r.renderLink(n))
+		default:
+			b.WriteString(r.renderInline(n.children))
+		}
+	}
+
+	return b.String()
+}
+
+// wrap surrounds s with delim, keeping surrounding whitespace outside of the
+// delimiters so the result still parses as emphasis.
+func wrap(s, delim string) string {
+	trimmed := strings.TrimSpace(s)
+	if trimmed == "" {
+		return s
+	}
+	start := strings.Index(s, trimmed)
+	return s[:start] + delim + trimmed + delim + s[start+len(trimmed):]
+}
+
+func (r *htmlReader) renderLink(n *node) string {
+	text := n.textContent()
+
+	if n.attrs["data-asana-type"] == "user" || (n.attrs["data-asana-gid"] != "" && n.attrs["href"] == "") {
+		name := strings.TrimPrefix(text, "@")
+		if name == "" {
+			name = r.mentionName(n.attrs["data-asana-gid"])
+		}
+		if strings.ContainsAny(name, " .-") {
+			return "@[" + name + "]"
+		}
+		return "@" + name
+	}
+
+	href := n.attrs["href"]
+	if href == "" {
+		return r.renderInline(n.children)
+	}
+	if text == "" || text == href {
+		return "<" + href + ">"
+	}
+	return "[" + r.renderInline(n.children) + "](" + href + ")"
+}
+
+var (
+	lineStartRe   = regexp.MustCompile(`^(\s*)([#>+-]|\d+[.)])`)
+	escapeInlineR = strings.NewReplacer(`\`, `\\`, "`", "\\`", "*", `\*`, "~~", `\~~`, "[", `\[`)
+)
+
+// escape protects text that would otherwise be read as Markdown syntax.
+// atLineStart reports whether s begins a new line of output.
+func escape(s string, atLineStart bool) string {
+	s = escapeInlineR.Replace(s)
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c == '_' || c == '@') && !wordBefore(s, i) {
+			b.WriteByte('\\')
+		} else if c == '_' && i+1 < len(s) && !isWordChar(s[i+1]) {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(c)
+	}
+	s = b.String()
+
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if i == 0 && !atLineStart {
+			continue
+		}
+		if m := lineStartRe.FindStringSubmatchIndex(line); m != nil {
+			// Escape the last character of the marker, e.g. "1\." or "\#".
+			at := m[5] - 1
+			lines[i] = line[:at] + `\` + line[at:]
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// mentionName returns the name of the user with the given GID, or the GID if
+// the user cannot be resolved.
+func (r *htmlReader) mentionName(gid string) string {
+	if r.names != nil {
+		if name, ok := r.names(gid); ok && name != "" {
+			return name
+		}
+	}
+	return gid
+}
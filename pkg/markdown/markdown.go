@@ -0,0 +1,431 @@
+// Package markdown converts between Markdown and the restricted HTML subset
+// Asana accepts for rich text fields such as html_notes and html_text.
+package markdown
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MentionResolver returns the GID of the user mentioned by name. It reports
+// false if no user matches, in which case the mention is kept as plain text.
+type MentionResolver func(name string) (string, bool)
+
+// MentionNameResolver returns the name of the user with the given GID. It
+// reports false if no user matches, in which case the GID is used instead.
+type MentionNameResolver func(gid string) (string, bool)
+
+var (
+	headingRe  = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	ruleRe     = regexp.MustCompile(`^\s{0,3}(-\s*-\s*-[-\s]*|\*\s*\*\s*\*[*\s]*|_\s*_\s*_[_\s]*)$`)
+	listItemRe = regexp.MustCompile(`^(\s*)([-*+]|\d+[.)])\s+(.*)$`)
+	fenceRe    = regexp.MustCompile("^\\s*(```|~~~)")
+	quoteRe    = regexp.MustCompile(`^\s{0,3}> ?(.*)$`)
+)
+
+// ToHTML converts Markdown to an Asana rich text document wrapped in <body>.
+// Mentions written as @name or @[Full Name] are resolved with mentions, which
+// may be nil.
+func ToHTML(md string, mentions MentionResolver) (string, error) {
+	c := &converter{mentions: mentions}
+
+	lines := strings.Split(strings.ReplaceAll(md, "\r\n", "\n"), "\n")
+	doc := "<body>" + c.blocks(lines) + "</body>"
+
+	if err := Validate(doc); err != nil {
+		return "", fmt.Errorf("generated invalid rich text: %w", err)
+	}
+
+	return doc, nil
+}
+
+// TextToHTML converts plain text to an Asana rich text document. Only
+// mentions are converted; everything else is kept verbatim.
+func TextToHTML(text string, mentions MentionResolver) (string, error) {
+	c := &converter{mentions: mentions}
+
+	var b strings.Builder
+	b.WriteString("<body>")
+	for i := 0; i < len(text); {
+		if text[i] == '@' && !wordBefore(text, i) {
+			if link, n, ok := c.mention(text[i:]); ok {
+				b.WriteString(link)
+				i += n
+				continue
+			}
+		}
+
+		_, size := utf8.DecodeRuneInString(text[i:])
+		b.WriteString(html.EscapeString(text[i : i+size]))
+		i += size
+	}
+	b.WriteString("</body>")
+
+	doc := b.String()
+	if err := Validate(doc); err != nil {
+		return "", fmt.Errorf("generated invalid rich text: %w", err)
+	}
+
+	return doc, nil
+}
+
+type converter struct {
+	mentions MentionResolver
+}
+
+type blockKind int
+
+const (
+	blockText blockKind = iota
+	blockElement
+)
+
+// blocks renders a sequence of Markdown lines. Paragraphs are separated by a
+// blank line, since Asana has no paragraph element and keeps newlines as is.
+func (c *converter) blocks(lines []string) string {
+	var b strings.Builder
+	prev := blockKind(-1)
+
+	emit := func(kind blockKind, s string) {
+		switch {
+		case prev == blockText && kind == blockText:
+			b.WriteString("\n\n")
+		case prev == blockText:
+			b.WriteString("\n")
+		}
+		b.WriteString(s)
+		prev = kind
+	}
+
+	for i := 0; i < len(lines); {
+		line := lines[i]
+
+		switch {
+		case strings.TrimSpace(line) == "":
+			i++
+
+		case fenceRe.MatchString(line):
+			fence := fenceRe.FindStringSubmatch(line)[1]
+			var code []string
+			i++
+			for i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), fence) {
+				code = append(code, lines[i])
+				i++
+			}
+			i++ // closing fence
+			emit(blockElement, "<pre>"+html.EscapeString(strings.Join(code, "\n"))+"</pre>")
+
+		case headingRe.MatchString(line):
+			m := headingRe.FindStringSubmatch(line)
+			tag := "h2"
+			if len(m[1]) == 1 {
+				tag = "h1"
+			}
+			emit(blockElement, "<"+tag+">"+c.inline(m[2])+"</"+tag+">")
+			i++
+
+		case ruleRe.MatchString(line):
+			emit(blockElement, "<hr/>")
+			i++
+
+		case quoteRe.MatchString(line):
+			var quoted []string
+			for i < len(lines) && quoteRe.MatchString(lines[i]) {
+				quoted = append(quoted, quoteRe.FindStringSubmatch(lines[i])[1])
+				i++
+			}
+			emit(blockElement, "<blockquote>"+c.blocks(quoted)+"</blockquote>")
+
+		case listItemRe.MatchString(line):
+			var list string
+			list, i = c.list(lines, i)
+			emit(blockElement, list)
+
+		default:
+			var para []string
+			for i < len(lines) && strings.TrimSpace(lines[i]) != "" && !isBlockStart(lines[i]) {
+				para = append(para, strings.TrimSpace(lines[i]))
+				i++
+			}
+			emit(blockText, c.inline(strings.Join(para, "\n")))
+		}
+	}
+
+	return b.String()
+}
+
+func isBlockStart(line string) bool {
+	return fenceRe.MatchString(line) ||
+		headingRe.MatchString(line) ||
+		ruleRe.MatchString(line) ||
+		quoteRe.MatchString(line) ||
+		listItemRe.MatchString(line)
+}
+
+func isOrdered(marker string) bool {
+	return marker[0] >= '0' && marker[0] <= '9'
+}
+
+// list renders the list starting at lines[start] and returns the index of the
+// first line after it. Items indented deeper than the list become nested lists.
+func (c *converter) list(lines []string, start int) (string, int) {
+	m := listItemRe.FindStringSubmatch(lines[start])
+	indent := len(m[1])
+	ordered := isOrdered(m[2])
+
+	tag := "ul"
+	if ordered {
+		tag = "ol"
+	}
+
+	var b strings.Builder
+	b.WriteString("<" + tag + ">")
+
+	i := start
+	for i < len(lines) {
+		m := listItemRe.FindStringSubmatch(lines[i])
+		if m == nil || len(m[1]) != indent || isOrdered(m[2]) != ordered {
+			break
+		}
+
+		text := []string{m[3]}
+		var nested strings.Builder
+		i++
+
+		for i < len(lines) {
+			line := lines[i]
+			if strings.TrimSpace(line) == "" {
+				// A blank line only continues the list if another item follows.
+				next := i + 1
+				for next < len(lines) && strings.TrimSpace(lines[next]) == "" {
+					next++
+				}
+				if next < len(lines) {
+					if nm := listItemRe.FindStringSubmatch(lines[next]); nm != nil && len(nm[1]) >= indent {
+						i = next
+						continue
+					}
+				}
+				break
+			}
+
+			if nm := listItemRe.FindStringSubmatch(line); nm != nil {
+				if len(nm[1]) <= indent {
+					break
+				}
+				var sub string
+				sub, i = c.list(lines, i)
+				nested.WriteString(sub)
+				continue
+			}
+
+			if leadingSpaces(line) <= indent || isBlockStart(line) {
+				break
+			}
+			text = append(text, strings.TrimSpace(line))
+			i++
+		}
+
+		b.WriteString("<li>" + c.inline(strings.Join(text, "\n")) + nested.String() + "</li>")
+	}
+
+	b.WriteString("</" + tag + ">")
+	return b.String(), i
+}
+
+func leadingSpaces(s string) int {
+	return len(s) - len(strings.TrimLeft(s, " \t"))
+}
+
+// inline renders emphasis, code spans, links and mentions within a block.
+func (c *converter) inline(s string) string {
+	var b strings.Builder
+
+	for i := 0; i < len(s); {
+		rest := s[i:]
+
+		switch {
+		case rest[0] == '\\' && len(rest) > 1 && isEscapable(rest[1]):
+			b.WriteString(html.EscapeString(rest[1:2]))
+			i += 2
+			continue
+
+		case rest[0] == '`':
+			if end := strings.IndexByte(rest[1:], '`'); end >= 0 {
+				b.WriteString("<code>" + html.EscapeString(rest[1:end+1]) + "</code>")
+				i += end + 2
+				continue
+			}
+
+		case strings.HasPrefix(rest, "**"), strings.HasPrefix(rest, "__"):
+			if inner, n, ok := delimited(s, i, rest[:2]); ok {
+				b.WriteString("<strong>" + c.inline(inner) + "</strong>")
+				i += n
+				continue
+			}
+
+		case strings.HasPrefix(rest, "~~"):
+			if inner, n, ok := delimited(s, i, "~~"); ok {
+				b.WriteString("<s>" + c.inline(inner) + "</s>")
+				i += n
+				continue
+			}
+
+		case rest[0] == '*', rest[0] == '_':
+			if inner, n, ok := delimited(s, i, rest[:1]); ok {
+				b.WriteString("<em>" + c.inline(inner) + "</em>")
+				i += n
+				continue
+			}
+
+		case rest[0] == '[':
+			if text, url, n, ok := link(rest); ok {
+				b.WriteString(`<a href="` + html.EscapeString(url) + `">` + c.inline(text) + "</a>")
+				i += n
+				continue
+			}
+
+		case rest[0] == '<':
+			if end := strings.IndexByte(rest, '>'); end > 0 && isURL(rest[1:end]) {
+				url := html.EscapeString(rest[1:end])
+				b.WriteString(`<a href="` + url + `">` + url + "</a>")
+				i += end + 1
+				continue
+			}
+
+		case rest[0] == '@' && !wordBefore(s, i):
+			if link, n, ok := c.mention(rest); ok {
+				b.WriteString(link)
+				i += n
+				continue
+			}
+		}
+
+		_, size := utf8.DecodeRuneInString(rest)
+		b.WriteString(html.EscapeString(rest[:size]))
+		i += size
+	}
+
+	return b.String()
+}
+
+// delimited finds the text enclosed by delim starting at s[i]. Underscores
+// only delimit at word boundaries so that snake_case stays intact.
+func delimited(s string, i int, delim string) (string, int, bool) {
+	if delim[0] == '_' && wordBefore(s, i) {
+		return "", 0, false
+	}
+
+	start := i + len(delim)
+	if start >= len(s) || s[start] == ' ' || s[start] == '\n' {
+		return "", 0, false
+	}
+
+	for j := start + 1; j+len(delim) <= len(s); j++ {
+		if s[j-1] == '\\' {
+			continue
+		}
+		if !strings.HasPrefix(s[j:], delim) || s[j-1] == ' ' {
+			continue
+		}
+		// A single delimiter must not match half of a double one.
+		if len(delim) == 1 && j+1 < len(s) && s[j+1] == delim[0] {
+			j++
+			continue
+		}
+		end := j + len(delim)
+		if delim[0] == '_' && end < len(s) && isWordChar(s[end]) {
+			continue
+		}
+		return s[start:j], end - i, true
+	}
+
+	return "", 0, false
+}
+
+// link parses [text](url) at the start of s.
+func link(s string) (string, string, int, bool) {
+	closeText := strings.Index(s, "](")
+	if closeText < 0 {
+		return "", "", 0, false
+	}
+	closeURL := strings.IndexByte(s[closeText+2:], ')')
+	if closeURL < 0 {
+		return "", "", 0, false
+	}
+
+	text := s[1:closeText]
+	url := strings.TrimSpace(s[closeText+2 : closeText+2+closeURL])
+	if text == "" || url == "" || strings.ContainsAny(url, " \n") {
+		return "", "", 0, false
+	}
+
+	return text, url, closeText + 3 + closeURL, true
+}
+
+// mention renders the mention at the start of s as a profile link, if the
+// mentioned user can be resolved.
+func (c *converter) mention(s string) (string, int, bool) {
+	if c.mentions == nil {
+		return "", 0, false
+	}
+
+	name, n, ok := mention(s)
+	if !ok {
+		return "", 0, false
+	}
+
+	gid, found := c.mentions(name)
+	if !found {
+		return "", 0, false
+	}
+
+	return `<a data-asana-gid="` + html.EscapeString(gid) + `"/>`, n, true
+}
+
+// mention parses @[Full Name] or @name at the start of s.
+func mention(s string) (string, int, bool) {
+	if strings.HasPrefix(s, "@[") {
+		end := strings.IndexByte(s, ']')
+		if end < 3 || strings.ContainsRune(s[:end], '\n') {
+			return "", 0, false
+		}
+		return s[2:end], end + 1, true
+	}
+
+	n := 1
+	for n < len(s) && (isWordChar(s[n]) || s[n] == '.' || s[n] == '-') {
+		n++
+	}
+	// A trailing dot ends the sentence rather than the name.
+	for n > 1 && s[n-1] == '.' {
+		n--
+	}
+	if n == 1 {
+		return "", 0, false
+	}
+
+	return s[1:n], n, true
+}
+
+func isURL(s string) bool {
+	return strings.HasPrefix(s, "http://") ||
+		strings.HasPrefix(s, "https://") ||
+		strings.HasPrefix(s, "mailto:")
+}
+
+func isEscapable(c byte) bool {
+	return strings.IndexByte("\\`*_~[]()#+-.!@>|", c) >= 0
+}
+
+func isWordChar(c byte) bool {
+	return c == '_' || c >= utf8.RuneSelf || unicode.IsLetter(rune(c)) || unicode.IsDigit(rune(c))
+}
+
+func wordBefore(s string, i int) bool {
+	return i > 0 && isWordChar(s[i-1])
+}
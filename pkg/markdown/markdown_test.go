@@ -0,0 +1,198 @@
+package markdown_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/pkg/markdown"
+)
+
+func resolver(name string) (string, bool) {
+	users := map[string]string{
+		"jane":     "111",
+		"John Doe": "222",
+	}
+	gid, ok := users[name]
+	return gid, ok
+}
+
+func names(gid string) (string, bool) {
+	users := map[string]string{
+		"111": "Jane Doe",
+		"222": "bob",
+	}
+	name, ok := users[gid]
+	return name, ok
+}
+
+func TestToHTML(t *testing.T) {
+	tests := []struct {
+		name string
+		md   string
+		want string
+	}{
+		{
+			name: "emphasis and code",
+			md:   "**bold**, *italic*, _also_, ~~gone~~ and `a < b`",
+			want: "<body><strong>bold</strong>, <em>italic</em>, <em>also</em>, <s>gone</s> and <code>a &lt; b</code></body>",
+		},
+		{
+			name: "snake case is not emphasis",
+			md:   "call some_func_name now",
+			want: "<body>call some_func_name now</body>",
+		},
+		{
+			name: "links",
+			md:   "[docs](https://example.com?a=1&b=2) and <https://asana.com>",
+			want: `<body><a href="https://example.com?a=1&amp;b=2">docs</a> and <a href="https://asana.com">https://asana.com</a></body>`,
+		},
+		{
+			name: "mentions",
+			md:   "ping @jane and @[John Doe], not @nobody or jane@example.com",
+			want: `<body>ping <a data-asana-gid="111"/> and <a data-asana-gid="222"/>, not @nobody or jane@example.com</body>`,
+		},
+		{
+			name: "paragraphs and line breaks",
+			md:   "first line\nsecond line\n\nnext paragraph",
+			want: "<body>first line\nsecond line\n\nnext paragraph</body>",
+		},
+		{
+			name: "nested lists",
+			md:   "Todo:\n- one\n- two\n  1. a\n  2. b\n- three",
+			want: "<body>Todo:\n<ul><li>one</li><li>two<ol><li>a</li><li>b</li></ol></li><li>three</li></ul></body>",
+		},
+		{
+			name: "headings, quotes, rules and code blocks",
+			md:   "# Title\n## Sub\n### Deeper\n> quoted\n\n---\n```\nx := <-ch\n```",
+			want: "<body><h1>Title</h1><h2>Sub</h2><h2>Deeper</h2><blockquote>quoted</blockquote><hr/><pre>x := &lt;-ch</pre></body>",
+		},
+		{
+			name: "escapes",
+			md:   `\*not bold\* and \@jane`,
+			want: "<body>*not bold* and @jane</body>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := markdown.ToHTML(tt.md, resolver)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFromHTML(t *testing.T) {
+	tests := []struct {
+		name string
+		html string
+		want string
+	}{
+		{
+			name: "inline formatting",
+			html: "<body><strong>bold</strong> <em>it</em> <u>under</u> <s>gone</s> <code>x*y</code></body>",
+			want: "**bold** *it* under ~~gone~~ `x*y`",
+		},
+		{
+			name: "mentions and links",
+			html: `<body>Hi <a href="https://app.asana.com/0/111/list" data-asana-gid="111" data-asana-type="user">@Jane Doe</a>, see <a href="https://example.com">the docs</a> or <a href="https://x.io">https://x.io</a></body>`,
+			want: "Hi @[Jane Doe], see [the docs](https://example.com) or <https://x.io>",
+		},
+		{
+			name: "mentions without link text",
+			html: `<body>Ask <a data-asana-gid="111"/> or <a data-asana-gid="222"/> and <a data-asana-gid="999"/></body>`,
+			want: "Ask @[Jane Doe] or @bob and @999",
+		},
+		{
+			name: "lists",
+			html: "<body>Steps:\n<ol><li>first</li><li>second<ul><li>detail</li></ul></li></ol>Done</body>",
+			want: "Steps:\n\n1. first\n2. second\n   - detail\n\nDone",
+		},
+		{
+			name: "blocks",
+			html: "<body><h1>Title</h1><blockquote>quote\nmore</blockquote><pre>a &lt; b</pre><hr/></body>",
+			want: "# Title\n\n> quote\n> more\n\n```\na < b\n```\n\n---",
+		},
+		{
+			name: "markdown characters in text are escaped",
+			html: "<body>2 * 3 = 6, keep snake_case\n# not a heading\n@ sign</body>",
+			want: "2 \\* 3 = 6, keep snake_case\n\\# not a heading\n\\@ sign",
+		},
+		{
+			name: "entities",
+			html: "<body>Tom &amp; Jerry&nbsp;&gt; Spike</body>",
+			want: "Tom & Jerry > Spike",
+		},
+		{
+			name: "empty",
+			html: "",
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := markdown.FromHTML(tt.html, names)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	md := "# Plan\n\nShip **v2** with *care*, ask @jane.\n\n- parse_input\n- render\n  1. html\n  2. markdown\n\n> Notes\n\n```\nfmt.Println(\"hi\")\n```\n\nSee [spec](https://example.com/spec)."
+
+	doc, err := markdown.ToHTML(md, resolver)
+	require.NoError(t, err)
+
+	// Asana returns mentions with the user's name as link text.
+	doc = replaceMention(doc, "111", "jane")
+
+	got, err := markdown.FromHTML(doc, nil)
+	require.NoError(t, err)
+	assert.Equal(t, md, got)
+}
+
+func replaceMention(doc, gid, name string) string {
+	tag := `<a data-asana-gid="` + gid + `"/>`
+	full := `<a data-asana-gid="` + gid + `" data-asana-type="user">@` + name + `</a>`
+	for i := 0; i+len(tag) <= len(doc); i++ {
+		if doc[i:i+len(tag)] == tag {
+			return doc[:i] + full + doc[i+len(tag):]
+		}
+	}
+	return doc
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		doc     string
+		wantErr string
+	}{
+		{name: "valid", doc: `<body><strong>x</strong><a href="https://x.io">x</a></body>`},
+		{name: "missing body", doc: "<strong>x</strong>", wantErr: "<body>"},
+		{name: "unsupported tag", doc: "<body><script>x</script></body>", wantErr: "unsupported tag <script>"},
+		{name: "unsupported attribute", doc: `<body><a onclick="x">x</a></body>`, wantErr: `unsupported attribute "onclick"`},
+		{name: "malformed", doc: "<body><em>x</body>", wantErr: "malformed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := markdown.Validate(tt.doc)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestTextToHTML(t *testing.T) {
+	got, err := markdown.TextToHTML("Thanks @jane & @[John Doe]!\n*not bold*", resolver)
+	require.NoError(t, err)
+	assert.Equal(t, `<body>Thanks <a data-asana-gid="111"/> &amp; <a data-asana-gid="222"/>!`+"\n*not bold*</body>", got)
+}
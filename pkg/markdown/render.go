@@ -0,0 +1,310 @@
+package markdown
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// RenderOptions controls how rich text is rendered for the terminal. Nil
+// style functions leave text unchanged, which yields plain text output.
+type RenderOptions struct {
+	// Width wraps text at the given number of columns. Zero disables wrapping.
+	Width int
+
+	Heading       func(string) string
+	Bold          func(string) string
+	Italic        func(string) string
+	Underline     func(string) string
+	Strikethrough func(string) string
+	Code          func(string) string
+	Mention       func(string) string
+	Dim           func(string) string
+
+	// Hyperlink turns text into a link to url. When nil, the URL is printed in
+	// parentheses after the link text.
+	Hyperlink func(text, url string) string
+}
+
+// Render formats Asana rich text, such as html_notes or a story's html_text,
+// for display in a terminal.
+func Render(doc string, opts RenderOptions) (string, error) {
+	if strings.TrimSpace(doc) == "" {
+		return "", nil
+	}
+
+	root, err := parse(doc)
+	if err != nil {
+		return "", err
+	}
+
+	width := opts.Width
+	if width <= 0 {
+		width = int(^uint(0) >> 1)
+	}
+
+	r := &renderer{opts: opts}
+	lines := r.blocks(root.children, width)
+
+	return strings.Trim(strings.Join(collapseBlankLines(lines), "\n"), "\n"), nil
+}
+
+type renderer struct {
+	opts RenderOptions
+}
+
+// segment is a run of text rendered with a single style.
+type segment struct {
+	text  string
+	style func(string) string
+	url   string
+}
+
+func apply(style func(string) string, s string) string {
+	if style == nil {
+		return s
+	}
+	return style(s)
+}
+
+func compose(outer, inner func(string) string) func(string) string {
+	if outer == nil {
+		return inner
+	}
+	if inner == nil {
+		return outer
+	}
+	return func(s string) string { return outer(inner(s)) }
+}
+
+func (r *renderer) blocks(nodes []*node, width int) []string {
+	var lines []string
+	var run []*node
+
+	flush := func() {
+		if len(run) == 0 {
+			return
+		}
+		text := r.wrap(r.inline(run, nil, ""), width)
+		run = nil
+
+		// Asana separates text from adjacent blocks with a single newline.
+		if len(text) > 0 && text[0] == "" {
+			text = text[1:]
+		}
+		if len(text) > 0 && text[len(text)-1] == "" {
+			text = text[:len(text)-1]
+		}
+		lines = append(lines, text...)
+	}
+
+	for _, n := range nodes {
+		if !isBlockTag(n.tag) {
+			run = append(run, n)
+			continue
+		}
+		flush()
+
+		switch n.tag {
+		case "h1", "h2":
+			style := compose(r.opts.Heading, r.opts.Bold)
+			lines = append(lines, "")
+			lines = append(lines, r.wrap(r.inline(n.children, style, ""), width)...)
+			lines = append(lines, "")
+
+		case "hr":
+			lines = append(lines, "", apply(r.opts.Dim, strings.Repeat("─", min(width, 40))), "")
+
+		case "pre":
+			lines = append(lines, "")
+			for _, line := range strings.Split(strings.Trim(n.textContent(), "\n"), "\n") {
+				lines = append(lines, "    "+apply(r.opts.Code, line))
+			}
+			lines = append(lines, "")
+
+		case "blockquote":
+			bar := apply(r.opts.Dim, "│ ")
+			lines = append(lines, "")
+			for _, line := range r.blocks(n.children, width-2) {
+				lines = append(lines, bar+line)
+			}
+			lines = append(lines, "")
+
+		case "ul", "ol":
+			lines = append(lines, r.list(n, width)...)
+		}
+	}
+	flush()
+
+	return lines
+}
+
+func (r *renderer) list(list *node, width int) []string {
+	var lines []string
+	number := 1
+
+	for _, item := range list.children {
+		if item.tag != "li" {
+			continue
+		}
+
+		marker := "• "
+		if list.tag == "ol" {
+			marker = strconv.Itoa(number) + ". "
+			number++
+		}
+		pad := strings.Repeat(" ", utf8.RuneCountInString(marker))
+
+		content := collapseBlankLines(r.blocks(item.children, width-len(pad)))
+		if len(content) == 0 {
+			content = []string{""}
+		}
+
+		for i, line := range content {
+			if i == 0 {
+				lines = append(lines, marker+line)
+			} else if line == "" {
+				lines = append(lines, "")
+			} else {
+				lines = append(lines, pad+line)
+			}
+		}
+	}
+
+	return lines
+}
+
+func (r *renderer) inline(nodes []*node, style func(string) string, url string) []segment {
+	var segs []segment
+
+	for _, n := range nodes {
+		switch n.tag {
+		case "":
+			segs = append(segs, segment{text: n.text, style: style, url: url})
+		case "strong", "b":
+			segs = append(segs, r.inline(n.children, compose(style, r.opts.Bold), url)...)
+		case "em", "i":
+			segs = append(segs, r.inline(n.children, compose(style, r.opts.Italic), url)...)
+		case "u":
+			segs = append(segs, r.inline(n.children, compose(style, r.opts.Underline), url)...)
+		case "s", "strike", "del":
+			segs = append(segs, r.inline(n.children, compose(style, r.opts.Strikethrough), url)...)
+		case "code":
+			segs = append(segs, segment{text: n.textContent(), style: compose(style, r.opts.Code), url: url})
+		case "br":
+			segs = append(segs, segment{text: "\n"})
+		case "a":
+			segs = append(segs, r.link(n, style)...)
+		default:
+			segs = append(segs, r.inline(n.children, style, url)...)
+		}
+	}
+
+	return segs
+}
+
+func (r *renderer) link(n *node, style func(string) string) []segment {
+	text := n.textContent()
+	href := n.attrs["href"]
+
+	if n.attrs["data-asana-type"] == "user" || (n.attrs["data-asana-gid"] != "" && text == "") {
+		if text == "" {
+			text = "@" + n.attrs["data-asana-gid"]
+		}
+		return []segment{{text: text, style: compose(style, r.opts.Mention)}}
+	}
+
+	if href == "" {
+		return r.inline(n.children, style, "")
+	}
+	if text == "" {
+		text = href
+	}
+
+	if r.opts.Hyperlink != nil {
+		return r.inline(n.children, compose(style, r.opts.Underline), href)
+	}
+
+	segs := []segment{{text: text, style: style}}
+	if text != href {
+		segs = append(segs, segment{text: " (" + href + ")", style: r.opts.Dim})
+	}
+	return segs
+}
+
+// wrap lays out segments in lines of at most width visible characters,
+// breaking at spaces. Styles are applied per word so that escape sequences
+// never span a line break.
+func (r *renderer) wrap(segs []segment, width int) []string {
+	var lines []string
+	var line, word strings.Builder
+	lineLen, wordLen := 0, 0
+
+	flushWord := func() {
+		if wordLen == 0 {
+			return
+		}
+		if lineLen > 0 && lineLen+1+wordLen > width {
+			lines = append(lines, line.String())
+			line.Reset()
+			lineLen = 0
+		}
+		if lineLen > 0 {
+			line.WriteByte(' ')
+			lineLen++
+		}
+		line.WriteString(word.String())
+		lineLen += wordLen
+		word.Reset()
+		wordLen = 0
+	}
+
+	flushLine := func() {
+		flushWord()
+		lines = append(lines, line.String())
+		line.Reset()
+		lineLen = 0
+	}
+
+	for _, seg := range segs {
+		for i, part := range strings.Split(seg.text, "\n") {
+			if i > 0 {
+				flushLine()
+			}
+			for j, w := range strings.Split(part, " ") {
+				if j > 0 {
+					flushWord()
+				}
+				if w == "" {
+					continue
+				}
+				styled := apply(seg.style, w)
+				if seg.url != "" {
+					styled = r.opts.Hyperlink(styled, seg.url)
+				}
+				word.WriteString(styled)
+				wordLen += utf8.RuneCountInString(w)
+			}
+		}
+	}
+	flushLine()
+
+	return lines
+}
+
+// collapseBlankLines removes leading blank lines and reduces runs of blank
+// lines to one.
+func collapseBlankLines(lines []string) []string {
+	out := make([]string, 0, len(lines))
+	for _, line := range lines {
+		blank := strings.TrimSpace(line) == ""
+		if blank && (len(out) == 0 || out[len(out)-1] == "") {
+			continue
+		}
+		if blank {
+			line = ""
+		}
+		out = append(out, line)
+	}
+	return out
+}
@@ -0,0 +1,81 @@
+package markdown_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timwehrle/asana/pkg/markdown"
+)
+
+func TestRender_Plain(t *testing.T) {
+	tests := []struct {
+		name  string
+		doc   string
+		width int
+		want  string
+	}{
+		{
+			name: "inline formatting is dropped",
+			doc:  "<body><strong>Bold</strong> and <em>italic</em> <code>x</code></body>",
+			want: "Bold and italic x",
+		},
+		{
+			name: "links and mentions",
+			doc:  `<body>Ask <a data-asana-gid="1" data-asana-type="user" href="https://app.asana.com/0/1/list">@Jane</a>, see <a href="https://x.io/spec">spec</a> or <a href="https://x.io">https://x.io</a></body>`,
+			want: "Ask @Jane, see spec (https://x.io/spec) or https://x.io",
+		},
+		{
+			name: "lists",
+			doc:  "<body>Todo:\n<ul><li>one</li><li>two<ol><li>a</li><li>b</li></ol></li></ul>Done</body>",
+			want: "Todo:\n• one\n• two\n  1. a\n  2. b\nDone",
+		},
+		{
+			name: "blocks",
+			doc:  "<body><h1>Title</h1>text<pre>code\n  indented</pre><blockquote>quoted</blockquote></body>",
+			want: "Title\n\ntext\n\n    code\n      indented\n\n│ quoted",
+		},
+		{
+			name:  "wrapping",
+			doc:   "<body>the quick brown fox jumps over the lazy dog\n\nnext</body>",
+			width: 15,
+			want:  "the quick brown\nfox jumps over\nthe lazy dog\n\nnext",
+		},
+		{
+			name:  "wrapped list items are indented",
+			doc:   "<body><ul><li>alpha beta gamma delta</li></ul></body>",
+			width: 14,
+			want:  "• alpha beta\n  gamma delta",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := markdown.Render(tt.doc, markdown.RenderOptions{Width: tt.width})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRender_Styled(t *testing.T) {
+	tag := func(name string) func(string) string {
+		return func(s string) string { return "<" + name + ">" + s + "</" + name + ">" }
+	}
+
+	opts := markdown.RenderOptions{
+		Width:   12,
+		Bold:    tag("b"),
+		Italic:  tag("i"),
+		Mention: tag("m"),
+		Hyperlink: func(text, url string) string {
+			return "[" + text + "|" + url + "]"
+		},
+	}
+
+	doc := `<body><strong>very bold</strong> <a data-asana-gid="1" data-asana-type="user">@Jo</a> <a href="https://x.io">a link</a></body>`
+
+	got, err := markdown.Render(doc, opts)
+	require.NoError(t, err)
+	assert.Equal(t, "<b>very</b> <b>bold</b>\n<m>@Jo</m> [a|https://x.io] [link|https://x.io]", got)
+}
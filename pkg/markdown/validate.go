@@ -0,0 +1,87 @@
+package markdown
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// allowedTags lists the elements Asana accepts in rich text, mapped to their
+// permitted attributes.
+var allowedTags = map[string][]string{
+	"body":       nil,
+	"strong":     nil,
+	"em":         nil,
+	"u":          nil,
+	"s":          nil,
+	"code":       nil,
+	"pre":        nil,
+	"h1":         nil,
+	"h2":         nil,
+	"ol":         nil,
+	"ul":         nil,
+	"li":         nil,
+	"blockquote": nil,
+	"hr":         nil,
+	"a":          {"href", "data-asana-gid", "data-asana-type", "data-asana-accessible", "data-asana-dynamic"},
+}
+
+// Validate checks that doc is well-formed rich text: a single <body> element
+// containing only tags and attributes Asana accepts.
+func Validate(doc string) error {
+	d := xml.NewDecoder(strings.NewReader(doc))
+	d.Strict = true
+
+	depth := 0
+	sawBody := false
+
+	for {
+		tok, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("malformed rich text: %w", err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			name := t.Name.Local
+			attrs, ok := allowedTags[name]
+			if !ok {
+				return fmt.Errorf("unsupported tag <%s>", name)
+			}
+			if depth == 0 {
+				if name != "body" || sawBody {
+					return errors.New("rich text must be wrapped in a single <body> element")
+				}
+				sawBody = true
+			} else if name == "body" {
+				return errors.New("<body> cannot be nested")
+			}
+			for _, a := range t.Attr {
+				if !slices.Contains(attrs, a.Name.Local) {
+					return fmt.Errorf("unsupported attribute %q on <%s>", a.Name.Local, name)
+				}
+			}
+			depth++
+
+		case xml.EndElement:
+			depth--
+
+		case xml.CharData:
+			if depth == 0 && strings.TrimSpace(string(t)) != "" {
+				return errors.New("text outside of <body>")
+			}
+		}
+	}
+
+	if !sawBody {
+		return errors.New("rich text must be wrapped in a single <body> element")
+	}
+
+	return nil
+}